@@ -0,0 +1,161 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Command vivoxaudit checks the hash chain of the audit log of issued Vivox tokens offline.
+//
+//	vivoxaudit verify [flags] <file>
+//
+// The rotated files of the log, file.1, file.2, ..., are verified with it, oldest first. A log written with
+// AUDIT_HMAC_KEY is verified with the same key, read from that variable by default.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/audit"
+)
+
+const usage = `usage:
+  vivoxaudit verify [flags] <file>
+
+Run a command with -h to list its flags.
+`
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// errInvalid is returned when the chain is broken, after the result is printed
+var errInvalid = errors.New("audit chain is not valid")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errInvalid) && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "vivoxaudit:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+
+		return flag.ErrHelp
+	}
+
+	switch args[0] {
+	case "verify":
+		return runVerify(args[1:], stdout, stderr)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(stdout, usage)
+
+		return nil
+	default:
+		fmt.Fprint(stderr, usage)
+
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// result is the output of verify
+type result struct {
+	Valid    bool     `json:"valid"`
+	Error    string   `json:"error,omitempty"`
+	Files    []string `json:"files"`
+	Entries  int      `json:"entries"`
+	FirstSeq int64    `json:"firstSeq,omitempty"`
+	LastSeq  int64    `json:"lastSeq,omitempty"`
+	LastTime string   `json:"lastTime,omitempty"`
+	LastHash string   `json:"lastHash,omitempty"`
+}
+
+func runVerify(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	output := flags.String("o", outputText, "output format, text or json")
+	rotated := flags.Bool("rotated", true, "verify the rotated files of the log before it")
+	keyEnv := flags.String("key-env", "AUDIT_HMAC_KEY", "environment variable holding the HMAC key of the chain, unset when written without key")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("exactly one audit file is required")
+	}
+	if *output != outputText && *output != outputJSON {
+		return fmt.Errorf("-o %q is not one of %s or %s", *output, outputText, outputJSON)
+	}
+
+	path := flags.Arg(0)
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	files := []string{path}
+	if *rotated {
+		files = audit.RotatedFiles(path)
+	}
+
+	report, err := audit.VerifyFiles([]byte(os.Getenv(*keyEnv)), files...)
+	if err != nil && !errors.Is(err, audit.ErrChainBroken) {
+		return err
+	}
+
+	res := result{Valid: err == nil, Files: files, Entries: report.Entries}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	if report.First != nil {
+		res.FirstSeq = report.First.Seq
+		res.LastSeq = report.Last.Seq
+		res.LastTime = report.Last.Time.Format(time.RFC3339)
+		res.LastHash = report.Last.Hash
+	}
+	if printErr := printResult(stdout, *output, res); printErr != nil {
+		return printErr
+	}
+	if !res.Valid {
+		return errInvalid
+	}
+
+	return nil
+}
+
+func printResult(w io.Writer, output string, res result) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(res)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	line := func(label string, value any) {
+		fmt.Fprintf(tw, "%s:\t%v\n", label, value)
+	}
+	line("valid", res.Valid)
+	if res.Error != "" {
+		line("error", res.Error)
+	}
+	line("files", len(res.Files))
+	line("entries", res.Entries)
+	if res.FirstSeq > 1 {
+		line("first entry", fmt.Sprintf("%d (older entries were rotated away)", res.FirstSeq))
+	} else if res.FirstSeq == 1 {
+		line("first entry", res.FirstSeq)
+	}
+	if res.LastSeq > 0 {
+		line("last entry", fmt.Sprintf("%d at %s", res.LastSeq, res.LastTime))
+		line("last hash", res.LastHash)
+	}
+
+	return tw.Flush()
+}
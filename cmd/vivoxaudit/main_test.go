@@ -0,0 +1,102 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"extend-rtu-vivox-authorization-service/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLog records count kick entries to a log rotated every two entries
+func writeLog(t *testing.T, count int, opts ...audit.LoggerOption) string {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := audit.NewFileSink(path, 900, 5)
+	require.NoError(t, err)
+	logger, err := audit.NewLogger(sink, []string{"kick"}, opts...)
+	require.NoError(t, err)
+	for serial := 1; serial <= count; serial++ {
+		require.NoError(t, logger.Record(audit.Entry{
+			Action: "kick", Namespace: "game", UserID: "moderator", TargetUserID: "griefer", Serial: int64(serial),
+			FromURI: "sip:.demo.moderator.@tla.vivox.com", SubURI: "sip:.demo.griefer.@tla.vivox.com",
+		}))
+	}
+	require.NoError(t, logger.Close())
+
+	return path
+}
+
+func verifyJSON(t *testing.T, args ...string) (result, error) {
+	var stdout, stderr bytes.Buffer
+	err := run(append([]string{"verify", "-o", "json"}, args...), &stdout, &stderr)
+
+	var res result
+	if stdout.Len() > 0 {
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	}
+
+	return res, err
+}
+
+func TestVerify(t *testing.T) {
+	path := writeLog(t, 5)
+
+	res, err := verifyJSON(t, path)
+
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+	assert.Greater(t, len(res.Files), 1, "the log is rotated")
+	assert.Equal(t, 5, res.Entries)
+	assert.Equal(t, int64(1), res.FirstSeq)
+	assert.Equal(t, int64(5), res.LastSeq)
+	assert.Len(t, res.LastHash, 64)
+
+	res, err = verifyJSON(t, "-rotated=false", path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{path}, res.Files)
+	assert.Less(t, res.Entries, 5)
+}
+
+func TestVerifyWithKey(t *testing.T) {
+	path := writeLog(t, 3, audit.WithKey([]byte("audit-key")))
+	t.Setenv("AUDIT_HMAC_KEY", "audit-key")
+
+	res, err := verifyJSON(t, path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, res.Entries)
+
+	t.Setenv("OTHER_KEY", "other-key")
+	res, err = verifyJSON(t, "-key-env", "OTHER_KEY", path)
+	require.ErrorIs(t, err, errInvalid)
+	assert.False(t, res.Valid)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	path := writeLog(t, 5)
+	data, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path+".1", []byte(strings.Replace(string(data), "griefer", "someone", 1)), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	err = run([]string{"verify", path}, &stdout, &stderr)
+
+	require.ErrorIs(t, err, errInvalid)
+	assert.Contains(t, stdout.String(), "valid:        false")
+	assert.Contains(t, stdout.String(), "audit.jsonl.1:1: audit chain is broken")
+}
+
+func TestVerifyMissingFile(t *testing.T) {
+	_, err := verifyJSON(t, filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errInvalid)
+}
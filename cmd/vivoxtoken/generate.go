@@ -0,0 +1,116 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+)
+
+// fixedSerial signs every token with the serial given on the command line
+type fixedSerial int64
+
+func (s fixedSerial) Next() int64 {
+	return int64(s)
+}
+
+var actions = []string{
+	vivox.ActionLogin, vivox.ActionJoin, vivox.ActionJoinMuted, vivox.ActionKick, vivox.ActionMute, "transcription",
+}
+
+func runGenerate(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		fmt.Fprintf(stderr, "usage: vivoxtoken generate <%s> [flags]\n", strings.Join(actions, "|"))
+
+		return errors.New("an action is required")
+	}
+	action := args[0]
+	if !slices.Contains(actions, action) {
+		return fmt.Errorf("unknown action %q, use one of %s", action, strings.Join(actions, ", "))
+	}
+
+	flags := flag.NewFlagSet("generate "+action, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var creds credentials
+	creds.register(flags)
+	user := flags.String("user", "", "user ID the token is issued to, required")
+	target := flags.String("target", "", "user ID to kick or mute, required for kick and mute")
+	channelID := flags.String("channel", "", "channel ID, required except for login and server-wide kick")
+	channelType := flags.String("channel-type", string(vivox.ChannelNonPositional), "echo, positional or nonpositional")
+	ttl := flags.Duration("ttl", 0, "token lifetime, defaults to VIVOX_DEFAULT_EXPIRY seconds")
+	serial := flags.Int64("serial", 0, "token serial number, random when 0")
+	output := flags.String("o", outputText, "output format, text or json")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if err := validateOutput(*output); err != nil {
+		return err
+	}
+	if err := creds.resolve(); err != nil {
+		return err
+	}
+	if *user == "" {
+		return errors.New("-user is required")
+	}
+	if !slices.Contains(vivox.IDEncodings, vivox.IDEncoding(creds.idEncoding)) {
+		return fmt.Errorf("-id-encoding %q is not one of none, percent or hash", creds.idEncoding)
+	}
+	if *ttl == 0 {
+		*ttl = time.Duration(creds.defaultExpiry) * time.Second
+	}
+
+	opts := []vivox.IssuerOption{
+		vivox.WithProtocol(creds.protocol),
+		vivox.WithChannelPrefix(creds.channelPrefix),
+		vivox.WithIDEncoding(vivox.IDEncoding(creds.idEncoding)),
+		vivox.WithClock(now),
+	}
+	if *serial != 0 {
+		opts = append(opts, vivox.WithSerialSource(fixedSerial(*serial)))
+	}
+	issuer := vivox.NewIssuer(creds.issuer, creds.domain, creds.signingKey, opts...)
+	channel := vivox.Channel{Type: vivox.ChannelType(*channelType), ID: *channelID}
+	switch channel.Type {
+	case vivox.ChannelEcho, vivox.ChannelPositional, vivox.ChannelNonPositional:
+	default:
+		return fmt.Errorf("-channel-type %q is not one of echo, positional or nonpositional", *channelType)
+	}
+
+	if channel.ID == "" && action != vivox.ActionLogin && action != vivox.ActionKick {
+		return errors.New("-channel is required")
+	}
+	if *target == "" && (action == vivox.ActionKick || action == vivox.ActionMute) {
+		return errors.New("-target is required")
+	}
+
+	var token vivox.Token
+	var err error
+	switch action {
+	case vivox.ActionLogin:
+		token, err = issuer.Login(*user, *ttl)
+	case vivox.ActionJoin:
+		token, err = issuer.Join(*user, channel, *ttl)
+	case vivox.ActionJoinMuted:
+		token, err = issuer.JoinMuted(*user, channel, *ttl)
+	case vivox.ActionKick:
+		token, err = issuer.Kick(*user, *target, channel, *ttl)
+	case vivox.ActionMute:
+		token, err = issuer.Mute(*user, *target, channel, *ttl)
+	case "transcription":
+		token, err = issuer.Transcription(*user, channel, *ttl)
+	}
+	if err != nil {
+		return err
+	}
+
+	return printResult(stdout, *output, result{AccessToken: token.AccessToken, Claims: &token.Claims})
+}
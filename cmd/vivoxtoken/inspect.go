@@ -0,0 +1,94 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+)
+
+func runDecode(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("decode", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	output := flags.String("o", outputText, "output format, text or json")
+	token, err := parseTokenArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if err = validateOutput(*output); err != nil {
+		return err
+	}
+
+	header, claims, err := vivox.Decode(token)
+	if err != nil {
+		return err
+	}
+
+	return printResult(stdout, *output, result{Header: header, Claims: claims})
+}
+
+func runVerify(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var creds credentials
+	creds.register(flags)
+	output := flags.String("o", outputText, "output format, text or json")
+	token, err := parseTokenArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if err = validateOutput(*output); err != nil {
+		return err
+	}
+	if err = creds.resolve(); err != nil {
+		return err
+	}
+
+	// try the active key, then the previous keys not yet retired
+	var claims *vivox.Claims
+	err = vivox.ErrTokenSignatureInvalid
+	for _, key := range creds.verificationKeys {
+		claims, err = vivox.Verify(token, key, now())
+		if !errors.Is(err, vivox.ErrTokenSignatureInvalid) {
+			break
+		}
+	}
+	if errors.Is(err, vivox.ErrTokenMalformed) {
+		return err
+	}
+	if err == nil && creds.issuer != "" && claims.Iss != creds.issuer {
+		err = fmt.Errorf("%w: expected %q, got %q", vivox.ErrTokenIssuerMismatch, creds.issuer, claims.Iss)
+	}
+
+	valid := err == nil
+	res := result{Valid: &valid, Claims: claims}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	if printErr := printResult(stdout, *output, res); printErr != nil {
+		return printErr
+	}
+	if !valid {
+		return errInvalid
+	}
+
+	return nil
+}
+
+// parseTokenArgs parses flags followed by exactly one token
+func parseTokenArgs(flags *flag.FlagSet, args []string) (string, error) {
+	if err := flags.Parse(args); err != nil {
+		return "", err
+	}
+	if flags.NArg() != 1 {
+		return "", fmt.Errorf("expected one token after the flags, got %d arguments", flags.NArg())
+	}
+
+	return flags.Arg(0), nil
+}
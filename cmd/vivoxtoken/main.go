@@ -0,0 +1,135 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Command vivoxtoken generates, decodes and verifies Vivox access tokens offline.
+//
+//	vivoxtoken generate <login|join|join_muted|kick|mute|transcription> [flags]
+//	vivoxtoken decode [flags] <token>
+//	vivoxtoken verify [flags] <token>
+//
+// The issuer, domain and signing key are taken from flags, then from the VIVOX_* environment
+// variables, then from the YAML config file of the service given with -config or CONFIG_FILE.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"extend-rtu-vivox-authorization-service/pkg/common"
+	"extend-rtu-vivox-authorization-service/pkg/service"
+)
+
+const usage = `usage:
+  vivoxtoken generate <login|join|join_muted|kick|mute|transcription> [flags]
+  vivoxtoken decode [flags] <token>
+  vivoxtoken verify [flags] <token>
+
+Run a command with -h to list its flags.
+`
+
+// errInvalid is returned when a verified token is not valid, after the result is printed
+var errInvalid = errors.New("token is not valid")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errInvalid) && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "vivoxtoken:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+
+		return flag.ErrHelp
+	}
+
+	switch args[0] {
+	case "generate":
+		return runGenerate(args[1:], stdout, stderr)
+	case "decode":
+		return runDecode(args[1:], stdout, stderr)
+	case "verify":
+		return runVerify(args[1:], stdout, stderr)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(stdout, usage)
+
+		return nil
+	default:
+		fmt.Fprint(stderr, usage)
+
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// credentials are the Vivox application settings shared by generate and verify
+type credentials struct {
+	configFile    string
+	issuer        string
+	domain        string
+	signingKey    string
+	protocol      string
+	channelPrefix string
+	idEncoding    string
+
+	defaultExpiry int
+	// keys accepted by verify, the active key first
+	verificationKeys []string
+}
+
+func (c *credentials) register(flags *flag.FlagSet) {
+	flags.StringVar(&c.configFile, "config", common.GetEnv("CONFIG_FILE", ""), "YAML config file of the service")
+	flags.StringVar(&c.issuer, "issuer", "", "Vivox issuer, defaults to VIVOX_ISSUER")
+	flags.StringVar(&c.domain, "domain", "", "Vivox domain, defaults to VIVOX_DOMAIN")
+	flags.StringVar(&c.signingKey, "key", "", "Vivox signing key, defaults to VIVOX_SIGNING_KEY or the active key of VIVOX_SIGNING_KEY_FILE")
+	flags.StringVar(&c.protocol, "protocol", "", "URI scheme, defaults to VIVOX_PROTOCOL")
+	flags.StringVar(&c.channelPrefix, "prefix", "", "channel name prefix, defaults to VIVOX_CHANNEL_PREFIX")
+	flags.StringVar(&c.idEncoding, "id-encoding", "", "none, percent or hash encoding of IDs Vivox does not accept, defaults to VIVOX_ID_ENCODING")
+}
+
+// resolve fills the settings not given as flags from the environment and config file
+func (c *credentials) resolve() error {
+	config, err := common.ReadConfig(c.configFile)
+	if err != nil {
+		return err
+	}
+
+	fallback := func(value *string, configured string) {
+		if *value == "" {
+			*value = configured
+		}
+	}
+	fallback(&c.issuer, config.Vivox.Issuer)
+	fallback(&c.domain, config.Vivox.Domain)
+	fallback(&c.protocol, config.Vivox.Protocol)
+	fallback(&c.channelPrefix, config.Vivox.ChannelPrefix)
+	fallback(&c.idEncoding, config.Vivox.IDEncoding)
+	c.defaultExpiry = config.Vivox.DefaultExpiry
+
+	switch {
+	case c.signingKey != "":
+		c.verificationKeys = []string{c.signingKey}
+	case config.Vivox.SigningKeyFile != "":
+		keys, err := service.LoadKeyring(config.Vivox.SigningKeyFile)
+		if err != nil {
+			return err
+		}
+		c.signingKey = keys.SigningKey()
+		c.verificationKeys = keys.VerificationKeys(now())
+	case config.Vivox.SigningKey != "":
+		c.signingKey = config.Vivox.SigningKey
+		c.verificationKeys = []string{c.signingKey}
+	}
+
+	if c.signingKey == "" {
+		return errors.New("a signing key is required, set -key, VIVOX_SIGNING_KEY or VIVOX_SIGNING_KEY_FILE")
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kickToken is signed with "secret!" and expires at 2016-01-01T00:00:00Z
+const kickToken = "e30.eyJ2eGkiOjMwMzE2Nywic3ViIjoic2lwOi5kZW1vLmtpbmdmaXNoZXIuMTM2NC5AdGxhLnZpdm94LmNvbSIsImYiOiJzaXA6LmRlbW8uRGVtby1BZG1pbi5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJraWNrIiwidCI6InNpcDpjb25mY3RsZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.AetRLye3w7pYpfhZWudGci8W3bgCET5y0ShZ7hkCHs8"
+
+func setup(t *testing.T, at time.Time) {
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("VIVOX_ISSUER", "demo")
+	t.Setenv("VIVOX_DOMAIN", "tla.vivox.com")
+	t.Setenv("VIVOX_SIGNING_KEY", "secret!")
+
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+func runJSON(t *testing.T, args ...string) (result, error) {
+	var stdout, stderr bytes.Buffer
+	err := run(append(args[:1:1], append([]string{"-o", "json"}, args[1:]...)...), &stdout, &stderr)
+
+	var res result
+	if stdout.Len() > 0 {
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	}
+
+	return res, err
+}
+
+func TestGenerate(t *testing.T) {
+	setup(t, time.Date(2015, 12, 31, 23, 58, 30, 0, time.UTC))
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"generate", "login", "-user", "baldeagle.1973", "-serial", "10047", "-ttl", "90s", "-o", "json"}, &stdout, &stderr)
+
+	require.NoError(t, err)
+	var res result
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, "e30.eyJ2eGkiOjEwMDQ3LCJmIjoic2lwOi5kZW1vLmJhbGRlYWdsZS4xOTczLkB0bGEudml2b3guY29tIiwiaXNzIjoiZGVtbyIsInZ4YSI6ImxvZ2luIiwiZXhwIjoxNDUxNjA2NDAwfQ.yJIgDg_l4hvkofzDXQEzuCELuLhurn_DVgF2mmUZls8", res.AccessToken)
+	assert.Equal(t, vivox.ActionLogin, res.Claims.Vxa)
+}
+
+func TestGenerateFlagsOverrideEnv(t *testing.T) {
+	setup(t, time.Now())
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"generate", "join", "-issuer", "other", "-key", "another-secret", "-user", "beef", "-channel", "lobby", "-channel-type", "echo"}, &stdout, &stderr)
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "sip:confctl-e-other.lobby@tla.vivox.com")
+	assert.Contains(t, stdout.String(), "token:")
+}
+
+func TestGenerateConfigFile(t *testing.T) {
+	setup(t, time.Now())
+	require.NoError(t, os.Unsetenv("VIVOX_SIGNING_KEY"))
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("vivox:\n  signingKey: from-file\n  defaultExpiry: 30\n"), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"generate", "login", "-config", path, "-user", "beef", "-o", "json"}, &stdout, &stderr)
+
+	require.NoError(t, err)
+	var res result
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	claims, err := vivox.Verify(res.AccessToken, "from-file", time.Now())
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(30*time.Second).Unix(), claims.Exp, 1)
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	setup(t, time.Now())
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing action", args: []string{"generate"}},
+		{name: "unknown action", args: []string{"generate", "ban", "-user", "beef"}},
+		{name: "missing user", args: []string{"generate", "login"}},
+		{name: "missing channel", args: []string{"generate", "join", "-user", "beef"}},
+		{name: "missing target", args: []string{"generate", "mute", "-user", "beef", "-channel", "lobby"}},
+		{name: "unknown channel type", args: []string{"generate", "join", "-user", "beef", "-channel", "lobby", "-channel-type", "-g-"}},
+		{name: "unknown output", args: []string{"generate", "login", "-user", "beef", "-o", "yaml"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			err := run(tt.args, &stdout, &stderr)
+
+			assert.Error(t, err)
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	setup(t, time.Now())
+
+	res, err := runJSON(t, "decode", kickToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, &vivox.Claims{
+		Vxi: 303167,
+		Sub: "sip:.demo.kingfisher.1364.@tla.vivox.com",
+		F:   "sip:.demo.Demo-Admin.@tla.vivox.com",
+		Iss: "demo",
+		Vxa: vivox.ActionKick,
+		T:   "sip:confctldemo.Qe3MHlbSq@tla.vivox.com",
+		Exp: 1451606400,
+	}, res.Claims)
+
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, run([]string{"decode", kickToken}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "header:")
+	assert.Contains(t, stdout.String(), "1451606400 (2016-01-01T00:00:00Z)")
+
+	_, err = runJSON(t, "decode", "not-a-token")
+	assert.ErrorIs(t, err, vivox.ErrTokenMalformed)
+}
+
+func TestVerify(t *testing.T) {
+	beforeExpiry := time.Date(2015, 12, 31, 23, 59, 0, 0, time.UTC)
+	afterExpiry := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		args      []string
+		wantValid bool
+		wantError string
+	}{
+		{name: "valid", now: beforeExpiry, wantValid: true},
+		{name: "expired", now: afterExpiry, wantError: "token is expired"},
+		{name: "wrong key", now: beforeExpiry, args: []string{"-key", "another-secret"}, wantError: "token signature is invalid"},
+		{name: "other issuer", now: beforeExpiry, args: []string{"-issuer", "other"}, wantError: "token issuer does not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup(t, tt.now)
+
+			res, err := runJSON(t, append(append([]string{"verify"}, tt.args...), kickToken)...)
+
+			require.NotNil(t, res.Valid)
+			assert.Equal(t, tt.wantValid, *res.Valid)
+			assert.Equal(t, "demo", res.Claims.Iss)
+			if tt.wantValid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errInvalid)
+				assert.Contains(t, res.Error, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// now is the clock of generated expiries and verification, replaced in tests
+var now = time.Now
+
+// result is the output of every command, with the fields that apply to it
+type result struct {
+	AccessToken string         `json:"accessToken,omitempty"`
+	Valid       *bool          `json:"valid,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Header      map[string]any `json:"header,omitempty"`
+	Claims      *vivox.Claims  `json:"claims,omitempty"`
+}
+
+func validateOutput(output string) error {
+	if output != outputText && output != outputJSON {
+		return fmt.Errorf("-o %q is not one of %s or %s", output, outputText, outputJSON)
+	}
+
+	return nil
+}
+
+func printResult(w io.Writer, output string, res result) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(res)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	line := func(label string, value any) {
+		fmt.Fprintf(tw, "%s:\t%v\n", label, value)
+	}
+	if res.AccessToken != "" {
+		line("token", res.AccessToken)
+	}
+	if res.Valid != nil {
+		line("valid", *res.Valid)
+	}
+	if res.Error != "" {
+		line("error", res.Error)
+	}
+	if res.Header != nil {
+		header, err := json.Marshal(res.Header)
+		if err != nil {
+			return err
+		}
+		line("header", string(header))
+	}
+	if claims := res.Claims; claims != nil {
+		line("action (vxa)", claims.Vxa)
+		line("serial (vxi)", claims.Vxi)
+		line("issuer (iss)", claims.Iss)
+		line("from (f)", claims.F)
+		if claims.T != "" {
+			line("to (t)", claims.T)
+		}
+		if claims.Sub != "" {
+			line("subject (sub)", claims.Sub)
+		}
+		line("expires (exp)", fmt.Sprintf("%d (%s)", claims.Exp, time.Unix(claims.Exp, 0).UTC().Format(time.RFC3339)))
+	}
+
+	return tw.Flush()
+}
@@ -18,6 +18,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -42,7 +43,9 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"extend-rtu-vivox-authorization-service/pkg/audit"
 	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
 
 	sdkAuth "github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
 	prometheusGrpc "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -60,7 +63,6 @@ const (
 
 var (
 	serviceName = "extend-app-vivox-auth"
-	logLevelStr = common.GetEnv("LOG_LEVEL", "info")
 )
 
 // parseSlogLevel converts string log level to slog.Level
@@ -80,8 +82,16 @@ func parseSlogLevel(levelStr string) slog.Level {
 }
 
 func main() {
-	// Parse log level from environment variable
-	slogLevel := parseSlogLevel(logLevelStr)
+	config, err := common.LoadConfig(common.GetEnv("CONFIG_FILE", ""))
+	if err != nil {
+		slog.Default().Error("invalid configuration", "error", err)
+		os.Exit(1)
+	}
+	common.BasePath = config.BasePath
+	common.Namespace = config.Namespace
+
+	// Parse log level from configuration
+	slogLevel := parseSlogLevel(config.LogLevel)
 
 	// Create JSON handler for structured logging
 	opts := &slog.HandlerOptions{
@@ -161,9 +171,8 @@ func main() {
 
 	}
 
-	if strings.ToLower(common.GetEnv("PLUGIN_GRPC_SERVER_AUTH_ENABLED", "true")) == "true" {
-		refreshInterval := common.GetEnvInt("REFRESH_INTERVAL", 600)
-		common.Validator = common.NewTokenValidator(oauthService, time.Duration(refreshInterval)*time.Second, true)
+	if config.AuthEnabled {
+		common.Validator = common.NewTokenValidator(oauthService, time.Duration(config.RefreshInterval)*time.Second, true)
 		err := common.Validator.Initialize(ctx)
 		if err != nil {
 			logger.Info(err.Error())
@@ -178,6 +187,15 @@ func main() {
 		logger.Info("added auth interceptors")
 	}
 
+	// Rate limit token issuance after authentication, so that buckets are keyed by the caller
+	var rateLimiter *common.RateLimiter
+	if config.Vivox.RateLimitEnabled {
+		rateLimiter = common.NewRateLimiter(config.Vivox.RateLimits)
+		unaryServerInterceptors = append(unaryServerInterceptors, common.NewUnaryRateLimitServerIntercept(rateLimiter))
+		streamServerInterceptors = append(streamServerInterceptors, common.NewStreamRateLimitServerIntercept(rateLimiter))
+		logger.Info("added rate limit interceptors")
+	}
+
 	// Create gRPC Server
 	s := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -194,7 +212,69 @@ func main() {
 	}
 
 	// Register Vivox Service
-	myServiceServer := service.NewMyServiceServer(tokenRepo, configRepo, refreshRepo, nil)
+	var serials vivox.SerialSource = vivox.NewCryptoSerialSource()
+	if config.Vivox.SerialSource == common.SerialSourceMonotonic {
+		serials = vivox.NewMonotonicSerialSource(time.Now().UnixMicro())
+	}
+	tenants, err := service.LoadTenantRegistryFromConfig(config.Vivox)
+	if err != nil {
+		logger.Error("unable to load vivox tenants", "error", err)
+		os.Exit(1)
+	}
+	tenants.Watch(ctx, time.Duration(config.Vivox.SigningKeyReloadPeriod)*time.Second)
+	serverOptions := []service.ServerOption{
+		service.WithSerialSource(serials),
+		service.WithTenantRegistry(tenants),
+	}
+	if rateLimiter != nil {
+		serverOptions = append(serverOptions, service.WithRateLimiter(rateLimiter))
+	}
+	// os.Exit skips deferred calls, the audit log is closed before every exit once it is open
+	closeAuditLog := func() {}
+	if config.Audit.Enabled {
+		auditSink, err := audit.NewFileSink(config.Audit.File, int64(config.Audit.MaxSize)<<20, config.Audit.MaxFiles)
+		if err != nil {
+			logger.Error("unable to open audit log", "error", err)
+			os.Exit(1)
+		}
+		var auditOptions []audit.LoggerOption
+		if config.Audit.HMACKey != "" {
+			auditOptions = append(auditOptions, audit.WithKey([]byte(config.Audit.HMACKey)))
+		}
+		auditLogger, err := audit.NewLogger(auditSink, config.Audit.Actions, auditOptions...)
+		if err != nil {
+			_ = auditSink.Close()
+			logger.Error("unable to continue audit log", "error", err)
+			os.Exit(1)
+		}
+		closeAuditLog = sync.OnceFunc(func() {
+			if err := auditLogger.Close(); err != nil {
+				logger.Error("unable to close audit log", "error", err)
+			}
+		})
+		serverOptions = append(serverOptions, service.WithAuditLogger(auditLogger))
+		logger.Info("audit log enabled", "file", config.Audit.File, "actions", config.Audit.Actions)
+	}
+	if config.Vivox.BansFile != "" {
+		bans, err := service.NewFileBanStore(config.Vivox.BansFile)
+		if err != nil {
+			logger.Error("unable to load voice bans", "error", err)
+			closeAuditLog()
+			os.Exit(1)
+		}
+		serverOptions = append(serverOptions, service.WithBanStore(bans))
+		logger.Info("voice bans persisted", "file", config.Vivox.BansFile)
+	}
+	sessions := service.NewSessionServiceLookup(tokenRepo, configRepo)
+	serverOptions = append(serverOptions, service.WithSessionLookup(sessions))
+	if config.Vivox.ChannelAuthorization == common.ChannelAuthorizationSession {
+		serverOptions = append(serverOptions, service.WithChannelAuthorizer(service.NewSessionChannelAuthorizer(sessions, config.Vivox)))
+		logger.Info("channel IDs authorized as session IDs")
+	}
+	if config.Vivox.IAMBansEnabled && !config.Vivox.IAMBansLookup {
+		logger.Warn("IAM bans are not checked on tokens requested on behalf of users, set VIVOX_IAM_BANS_LOOKUP to check them")
+	}
+	myServiceServer := service.NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil, serverOptions...)
 	pb.RegisterServiceServer(s, myServiceServer)
 
 	// Enable gRPC Reflection
@@ -208,6 +288,7 @@ func main() {
 	grpcGateway, err := common.NewGateway(ctx, fmt.Sprintf("localhost:%d", grpcServerPort))
 	if err != nil {
 		logger.Error("Failed to create gRPC-Gateway", "error", err)
+		closeAuditLog()
 		os.Exit(1)
 	}
 
@@ -218,6 +299,7 @@ func main() {
 		logger.Info("Starting gRPC-Gateway HTTP server", "port", grpcGatewayHTTPPort)
 		if err := grpcGatewayHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			logger.Error("Failed to run gRPC-Gateway HTTP server", "error", err)
+			closeAuditLog()
 			os.Exit(1)
 		}
 	}()
@@ -231,11 +313,13 @@ func main() {
 		prometheusCollectors.NewProcessCollector(prometheusCollectors.ProcessCollectorOpts{}),
 		prometheusGrpc.DefaultServerMetrics,
 	)
+	prometheusRegistry.MustRegister(common.MetricsCollectors()...)
 
 	go func() {
 		http.Handle(metricsEndpoint, promhttp.HandlerFor(prometheusRegistry, promhttp.HandlerOpts{}))
 		if err := http.ListenAndServe(fmt.Sprintf(":%d", metricsPort), nil); err != nil {
 			logger.Error("failed to serve prometheus metrics", "error", err)
+			closeAuditLog()
 			os.Exit(1)
 		}
 	}()
@@ -248,11 +332,13 @@ func main() {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcServerPort))
 	if err != nil {
 		logger.Error("failed to listen to tcp", "port", grpcServerPort, "error", err)
+		closeAuditLog()
 		os.Exit(1)
 	}
 	go func() {
 		if err = s.Serve(lis); err != nil {
 			logger.Error("failed to run gRPC server", "error", err)
+			closeAuditLog()
 			os.Exit(1)
 		}
 	}()
@@ -263,6 +349,7 @@ func main() {
 	defer stop()
 	<-ctx.Done()
 	logger.Info("signal received")
+	closeAuditLog()
 }
 
 func newGRPCGatewayHTTPServer(
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package audit records the issued Vivox tokens in a hash chain, so that removed or altered entries are detected.
+// Without a key anyone able to write the log can recompute the chain, and entries removed from its end are only
+// detected against a copy of the last hash kept elsewhere.
+package audit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Entry is one issued token. Hash covers every other field, including PrevHash, the Hash of the previous entry.
+type Entry struct {
+	Seq            int64     `json:"seq"`
+	Time           time.Time `json:"time"`
+	Action         string    `json:"action"`
+	Namespace      string    `json:"namespace"`
+	CallerUserID   string    `json:"callerUserId,omitempty"`
+	CallerClientID string    `json:"callerClientId,omitempty"`
+	UserID         string    `json:"userId"`
+	TargetUserID   string    `json:"targetUserId,omitempty"`
+	ChannelID      string    `json:"channelId,omitempty"`
+	FromURI        string    `json:"fromUri"`
+	ToURI          string    `json:"toUri,omitempty"`
+	SubURI         string    `json:"subUri,omitempty"`
+	Serial         int64     `json:"serial"`
+	ExpiresAt      int64     `json:"expiresAt"`
+	PrevHash       string    `json:"prevHash"`
+	Hash           string    `json:"hash,omitempty"`
+}
+
+// ComputeHash returns the HMAC-SHA256 keyed by key of the entry without its Hash, or its SHA-256 when key is empty,
+// hex encoded
+func (e Entry) ComputeHash(key []byte) (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal audit entry")
+	}
+	if len(key) == 0 {
+		sum := sha256.Sum256(data)
+
+		return hex.EncodeToString(sum[:]), nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Sink stores the entries of a chain in the order they are written
+type Sink interface {
+	// Write appends entry after the previously written ones
+	Write(entry Entry) error
+	// Last returns the last entry written, nil when there is none, so that the chain continues across restarts
+	Last() (*Entry, error)
+	Close() error
+}
+
+// Logger chains the entries of the audited actions and writes them to a Sink
+type Logger struct {
+	sink    Sink
+	actions map[string]bool
+	key     []byte
+	now     func() time.Time
+
+	mu   sync.Mutex
+	last *Entry
+}
+
+// LoggerOption configures optional settings of a Logger
+type LoggerOption func(*Logger)
+
+// WithKey chains the entries with an HMAC keyed by key, so that only holders of the key can recompute the chain.
+// The chain is verified with the same key, which must not change for the life of the log.
+func WithKey(key []byte) LoggerOption {
+	return func(l *Logger) {
+		l.key = key
+	}
+}
+
+// NewLogger returns a Logger recording actions, e.g. kick, continuing the chain already in sink
+func NewLogger(sink Sink, actions []string, opts ...LoggerOption) (*Logger, error) {
+	last, err := sink.Last()
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Logger{
+		sink:    sink,
+		actions: make(map[string]bool, len(actions)),
+		now:     time.Now,
+		last:    last,
+	}
+	for _, action := range actions {
+		l.actions[action] = true
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l, nil
+}
+
+// Audits reports whether the tokens of action are recorded
+func (l *Logger) Audits(action string) bool {
+	return l.actions[action]
+}
+
+// Record chains entry after the last recorded one and writes it. Entries of actions not audited are ignored.
+func (l *Logger) Record(entry Entry) error {
+	if !l.Audits(entry.Action) {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.Seq, entry.PrevHash = 1, ""
+	if l.last != nil {
+		entry.Seq, entry.PrevHash = l.last.Seq+1, l.last.Hash
+	}
+	entry.Time = l.now().UTC()
+
+	var err error
+	if entry.Hash, err = entry.ComputeHash(l.key); err != nil {
+		return err
+	}
+	if err = l.sink.Write(entry); err != nil {
+		return err
+	}
+	l.last = &entry
+
+	return nil
+}
+
+// Close closes the sink
+func (l *Logger) Close() error {
+	return l.sink.Close()
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(t *testing.T, path string) *Logger {
+	sink, err := NewFileSink(path, 1<<20, 3)
+	require.NoError(t, err)
+	logger, err := NewLogger(sink, []string{"kick", "mute"})
+	require.NoError(t, err)
+	logger.now = func() time.Time { return time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { _ = logger.Close() })
+
+	return logger
+}
+
+func kickEntry(serial int64) Entry {
+	return Entry{
+		Action:         "kick",
+		Namespace:      "game",
+		CallerUserID:   "moderator",
+		CallerClientID: "client",
+		UserID:         "moderator",
+		TargetUserID:   "griefer",
+		ChannelID:      "lobby",
+		FromURI:        "sip:.demo.moderator.@tla.vivox.com",
+		ToURI:          "sip:confctl-g-demo.lobby@tla.vivox.com",
+		SubURI:         "sip:.demo.griefer.@tla.vivox.com",
+		Serial:         serial,
+		ExpiresAt:      1792238490,
+	}
+}
+
+func TestLoggerRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	logger := newTestLogger(t, path)
+
+	require.NoError(t, logger.Record(kickEntry(1)))
+	require.NoError(t, logger.Record(Entry{Action: "login", UserID: "player"}), "login is not audited")
+	require.NoError(t, logger.Record(kickEntry(2)))
+	require.NoError(t, logger.Close())
+
+	// the chain continues after a restart
+	logger = newTestLogger(t, path)
+	require.NoError(t, logger.Record(kickEntry(3)))
+
+	report, err := VerifyFiles(nil, path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Entries)
+	assert.Equal(t, int64(1), report.First.Seq)
+	assert.Empty(t, report.First.PrevHash)
+	assert.Equal(t, int64(3), report.Last.Seq)
+	assert.Equal(t, int64(3), report.Last.Serial)
+	assert.Equal(t, "griefer", report.Last.TargetUserID)
+}
+
+func TestLoggerRecordWithKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileSink(path, 1<<20, 3)
+	require.NoError(t, err)
+	logger, err := NewLogger(sink, []string{"kick"}, WithKey([]byte("audit-key")))
+	require.NoError(t, err)
+	for serial := int64(1); serial <= 2; serial++ {
+		require.NoError(t, logger.Record(kickEntry(serial)))
+	}
+	require.NoError(t, logger.Close())
+
+	report, err := VerifyFiles([]byte("audit-key"), path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Entries)
+
+	_, err = VerifyFiles([]byte("other-key"), path)
+	require.ErrorIs(t, err, ErrChainBroken)
+
+	// an entry rewritten and hashed without the key does not chain
+	forged := *report.Last
+	forged.TargetUserID = "someone"
+	forged.Hash, err = forged.ComputeHash(nil)
+	require.NoError(t, err)
+	line, err := json.Marshal(forged)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.NoError(t, os.WriteFile(path, []byte(lines[0]+string(line)+"\n"), 0o600))
+
+	_, err = VerifyFiles([]byte("audit-key"), path)
+	require.ErrorContains(t, err, "entry 2 was altered")
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger := newTestLogger(t, path)
+	for serial := int64(1); serial <= 3; serial++ {
+		require.NoError(t, logger.Record(kickEntry(serial)))
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name:  "altered entry",
+			lines: []string{lines[0], strings.Replace(lines[1], `"targetUserId":"griefer"`, `"targetUserId":"someone"`, 1), lines[2]},
+			want:  "audit.jsonl:2: audit chain is broken: entry 2 was altered",
+		},
+		{
+			name:  "removed entry",
+			lines: []string{lines[0], lines[2]},
+			want:  "audit.jsonl:2: audit chain is broken: entry 3 follows entry 1",
+		},
+		{
+			name:  "reordered entries",
+			lines: []string{lines[1], lines[0], lines[2]},
+			want:  "audit.jsonl:2: audit chain is broken: entry 1 follows entry 2",
+		},
+		{
+			name:  "added field",
+			lines: []string{strings.Replace(lines[0], `{"seq"`, `{"note":"x","seq"`, 1)},
+			want:  "audit.jsonl:1: audit chain is broken: entry is malformed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tampered := filepath.Join(t.TempDir(), "audit.jsonl")
+			require.NoError(t, os.WriteFile(tampered, []byte(strings.Join(tt.lines, "")), 0o600))
+
+			_, err := VerifyFiles(nil, tampered)
+
+			require.ErrorIs(t, err, ErrChainBroken)
+			assert.Contains(t, err.Error(), filepath.Dir(tampered))
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// entries are at most a few kilobytes, lines longer than this are not entries
+const maxLineSize = 1 << 20
+
+// FileSink writes entries as JSON lines to a file, rotated to path.1, path.2, ... once it reaches maxSize bytes
+type FileSink struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens path for appending, creating it and its directory when missing.
+// At most maxFiles rotated files are kept, older ones are removed.
+func NewFileSink(path string, maxSize int64, maxFiles int) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, errors.Wrap(err, "create audit directory")
+	}
+
+	s := &FileSink{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o640)
+	if err != nil {
+		return errors.Wrap(err, "open audit file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return errors.Wrap(err, "stat audit file")
+	}
+	s.file, s.size = file, info.Size()
+
+	return nil
+}
+
+// Write appends entry as a line and syncs it to disk
+func (s *FileSink) Write(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "marshal audit entry")
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "write audit entry")
+	}
+
+	return errors.Wrap(s.file.Sync(), "sync audit file")
+}
+
+// rotate shifts path.i to path.i+1, dropping the oldest, moves path to path.1 and reopens path
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return errors.Wrap(err, "close audit file")
+	}
+
+	if err := os.Remove(rotatedName(s.path, s.maxFiles)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove oldest audit file")
+	}
+	for i := s.maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(rotatedName(s.path, i), rotatedName(s.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "rotate audit file")
+		}
+	}
+	if s.maxFiles > 0 {
+		if err := os.Rename(s.path, rotatedName(s.path, 1)); err != nil {
+			return errors.Wrap(err, "rotate audit file")
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return errors.Wrap(err, "remove audit file")
+	}
+
+	return s.open()
+}
+
+// Last returns the last entry of the newest file holding one
+func (s *FileSink) Last() (*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files := RotatedFiles(s.path)
+	for i := len(files) - 1; i >= 0; i-- {
+		last, err := lastEntry(files[i])
+		if err != nil || last != nil {
+			return last, err
+		}
+	}
+
+	return nil, nil
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// RotatedFiles returns the existing files of the chain written to path, oldest first
+func RotatedFiles(path string) []string {
+	var rotated []string
+	for i := 1; ; i++ {
+		if _, err := os.Stat(rotatedName(path, i)); err != nil {
+			break
+		}
+		rotated = append(rotated, rotatedName(path, i))
+	}
+
+	files := make([]string, 0, len(rotated)+1)
+	for i := len(rotated) - 1; i >= 0; i-- {
+		files = append(files, rotated[i])
+	}
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	}
+
+	return files
+}
+
+func rotatedName(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+func lastEntry(path string) (*Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open audit file")
+	}
+	defer file.Close()
+
+	var line []byte
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) > 0 {
+			line = append(line[:0], scanner.Bytes()...)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "read %s", path)
+	}
+	if line == nil {
+		return nil, nil
+	}
+
+	var entry Entry
+	if err = json.Unmarshal(line, &entry); err != nil {
+		return nil, errors.Wrapf(err, "last entry of %s is malformed", path)
+	}
+
+	return &entry, nil
+}
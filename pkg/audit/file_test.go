@@ -0,0 +1,50 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package audit
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSinkRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	line, err := json.Marshal(kickEntry(1))
+	require.NoError(t, err)
+	// room for two entries per file, hashes and sequence numbers add some bytes
+	sink, err := NewFileSink(path, int64(2*len(line)+400), 2)
+	require.NoError(t, err)
+	logger, err := NewLogger(sink, []string{"kick"})
+	require.NoError(t, err)
+
+	for serial := int64(1); serial <= 7; serial++ {
+		require.NoError(t, logger.Record(kickEntry(serial)))
+	}
+	require.NoError(t, logger.Close())
+
+	files := RotatedFiles(path)
+	assert.Equal(t, []string{path + ".2", path + ".1", path}, files)
+
+	// the oldest entries were rotated away, the rest still chain
+	report, err := VerifyFiles(nil, files...)
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.Entries)
+	assert.Equal(t, int64(3), report.First.Seq)
+	assert.Equal(t, int64(7), report.Last.Seq)
+
+	_, err = VerifyFiles(nil, path+".2", path)
+	assert.ErrorIs(t, err, ErrChainBroken, "a missing file breaks the chain")
+
+	sink, err = NewFileSink(path, 1<<20, 2)
+	require.NoError(t, err)
+	defer sink.Close()
+	last, err := sink.Last()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), last.Seq)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// ErrChainBroken is wrapped by the ChainError of an entry that was altered, inserted or follows removed entries
+var ErrChainBroken = errors.New("audit chain is broken")
+
+// ChainError locates the first entry breaking the chain
+type ChainError struct {
+	File   string
+	Line   int
+	Reason string
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("%s:%d: %s: %s", e.File, e.Line, ErrChainBroken, e.Reason)
+}
+
+func (e *ChainError) Unwrap() error {
+	return ErrChainBroken
+}
+
+// Report summarizes a verified chain
+type Report struct {
+	Entries int
+	// First is nil when there are no entries. When its Seq is not 1, the older entries were rotated away
+	// and the chain is only verified from First on.
+	First *Entry
+	Last  *Entry
+}
+
+// Verifier checks that entries are chained, reading them file after file
+type Verifier struct {
+	// Key is the HMAC key of the chain, empty when it was written without key
+	Key    []byte
+	report Report
+}
+
+// VerifyFiles checks the chain of the given files, oldest first, written with key
+func VerifyFiles(key []byte, paths ...string) (Report, error) {
+	v := Verifier{Key: key}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return v.report, errors.Wrap(err, "open audit file")
+		}
+		err = v.Verify(path, file)
+		_ = file.Close()
+		if err != nil {
+			return v.report, err
+		}
+	}
+
+	return v.report, nil
+}
+
+// Verify checks the entries of r, named name in errors, continuing the chain of the previously verified ones
+func (v *Verifier) Verify(name string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		broken := func(format string, args ...any) error {
+			return &ChainError{File: name, Line: line, Reason: fmt.Sprintf(format, args...)}
+		}
+
+		var entry Entry
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&entry); err != nil {
+			return broken("entry is malformed: %v", err)
+		}
+		hash, err := entry.ComputeHash(v.Key)
+		if err != nil {
+			return err
+		}
+		if entry.Hash != hash {
+			return broken("entry %d was altered, its hash is %s, expected %s", entry.Seq, entry.Hash, hash)
+		}
+
+		last := v.report.Last
+		switch {
+		case last == nil && entry.Seq == 1 && entry.PrevHash != "":
+			return broken("first entry has a previous hash")
+		case last == nil:
+		case entry.Seq != last.Seq+1:
+			return broken("entry %d follows entry %d", entry.Seq, last.Seq)
+		case entry.PrevHash != last.Hash:
+			return broken("entry %d does not chain to entry %d", entry.Seq, last.Seq)
+		}
+
+		if v.report.First == nil {
+			v.report.First = &entry
+		}
+		v.report.Last = &entry
+		v.report.Entries++
+	}
+
+	return errors.Wrapf(scanner.Err(), "read %s", name)
+}
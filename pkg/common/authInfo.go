@@ -0,0 +1,34 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+)
+
+type authInfoKey struct{}
+
+// AuthInfo holds the validated access token of the caller and its claims
+type AuthInfo struct {
+	Token  string
+	Claims iam.JWTClaims
+}
+
+// UserID returns the subject of the access token, empty for client tokens
+func (a *AuthInfo) UserID() string {
+	return a.Claims.Subject
+}
+
+func ContextWithAuthInfo(ctx context.Context, authInfo *AuthInfo) context.Context {
+	return context.WithValue(ctx, authInfoKey{}, authInfo)
+}
+
+func AuthInfoFromContext(ctx context.Context) (*AuthInfo, bool) {
+	authInfo, ok := ctx.Value(authInfoKey{}).(*AuthInfo)
+
+	return authInfo, ok && authInfo != nil
+}
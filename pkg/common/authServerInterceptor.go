@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -27,6 +28,7 @@ import (
 	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth/validator"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"github.com/pkg/errors"
 )
 
@@ -38,6 +40,12 @@ type ProtoPermissionExtractor interface {
 	ExtractPermission(infoUnary *grpc.UnaryServerInfo, infoStream *grpc.StreamServerInfo) (permission *iam.Permission, err error)
 }
 
+// ProtoRequestPermissionExtractor is optionally implemented by a ProtoPermissionExtractor
+// to also check the permissions stated on the values of each request
+type ProtoRequestPermissionExtractor interface {
+	ExtractRequestPermissions(req interface{}) (permissions []*iam.Permission, err error)
+}
+
 func NewProtoPermissionExtractor() *ProtoPermissionExtractorImpl {
 	return &ProtoPermissionExtractorImpl{}
 }
@@ -83,6 +91,44 @@ func (p *ProtoPermissionExtractorImpl) ExtractPermission(infoUnary *grpc.UnarySe
 	return &permission, nil
 }
 
+// ExtractRequestPermissions reads the permissions stated on the enum values set in the request,
+// e.g. the per-action permission of GenerateVivoxTokenRequestType
+func (p *ProtoPermissionExtractorImpl) ExtractRequestPermissions(req interface{}) ([]*iam.Permission, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	return RequestPermissions(msg), nil
+}
+
+// RequestPermissions returns the permissions stated on the enum values set in the top level fields of msg
+func RequestPermissions(msg proto.Message) []*iam.Permission {
+	var permissions []*iam.Permission
+	msg.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.EnumKind || field.IsList() || field.IsMap() {
+			return true
+		}
+
+		enumValue := field.Enum().Values().ByNumber(value.Enum())
+		if enumValue == nil {
+			return true
+		}
+
+		resource := proto.GetExtension(enumValue.Options(), pb.E_ValueResource).(string)
+		if resource == "" {
+			return true
+		}
+		action := proto.GetExtension(enumValue.Options(), pb.E_ValueAction).(pb.Action)
+		permission := wrapPermission(resource, int(action.Number()))
+		permissions = append(permissions, &permission)
+
+		return true
+	})
+
+	return permissions
+}
+
 func NewUnaryAuthServerIntercept(
 	permissionExtractor ProtoPermissionExtractor,
 ) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) { // nolint
@@ -95,10 +141,17 @@ func NewUnaryAuthServerIntercept(
 				return nil, err
 			}
 
-			err = checkAuthorizationMetadata(ctx, permission)
+			ctx, err = checkAuthorizationMetadata(ctx, permission, requestNamespace(req))
 			if err != nil {
 				return nil, err
 			}
+
+			// Check the permissions stated on the request values, e.g. per-action permission
+			if requestExtractor, ok := permissionExtractor.(ProtoRequestPermissionExtractor); ok {
+				if err = checkRequestPermissions(ctx, requestExtractor, req); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		return handler(ctx, req)
@@ -140,16 +193,63 @@ func NewStreamAuthServerIntercept(
 				return err
 			}
 
-			err = checkAuthorizationMetadata(ss.Context(), permission)
+			// the request is not received yet, its namespace is checked with the permissions of its values
+			ctx, err := checkAuthorizationMetadata(ss.Context(), permission, "")
 			if err != nil {
 				return err
 			}
+
+			wrapped := middleware.WrapServerStream(ss)
+			wrapped.WrappedContext = ctx
+			ss = wrapped
+			if requestExtractor, ok := permissionExtractor.(ProtoRequestPermissionExtractor); ok {
+				ss = &authServerStream{WrappedServerStream: wrapped, permissionExtractor: requestExtractor}
+			}
 		}
 
 		return handler(srv, ss)
 	}
 }
 
+// authServerStream checks the permissions stated on the enum values of each received request
+type authServerStream struct {
+	*middleware.WrappedServerStream
+	permissionExtractor ProtoRequestPermissionExtractor
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return checkRequestPermissions(s.Context(), s.permissionExtractor, m)
+}
+
+// checkRequestPermissions checks the caller against the permissions stated on the values of req
+func checkRequestPermissions(ctx context.Context, permissionExtractor ProtoRequestPermissionExtractor, req interface{}) error {
+	requestPermissions, err := permissionExtractor.ExtractRequestPermissions(req)
+	if err != nil {
+		return err
+	}
+	namespace := PermissionNamespace(ctx, requestNamespace(req))
+	for _, requestPermission := range requestPermissions {
+		if err = CheckPermission(ctx, requestPermission, namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requestNamespace returns the namespace requested by req, empty when it has none
+func requestNamespace(req interface{}) string {
+	if r, ok := req.(interface{ GetNamespace() string }); ok {
+		return r.GetNamespace()
+	}
+
+	return ""
+}
+
 func skipCheckAuthorizationMetadata(fullMethod string) bool {
 	if strings.HasPrefix(fullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
 		return true
@@ -162,14 +262,20 @@ func skipCheckAuthorizationMetadata(fullMethod string) bool {
 	return false
 }
 
-func checkAuthorizationMetadata(ctx context.Context, permission *iam.Permission) error {
+// checkAuthorizationMetadata validates the caller's access token against permission in reqNamespace,
+// or in the namespace of the token when no namespace is requested
+func checkAuthorizationMetadata(ctx context.Context, permission *iam.Permission, reqNamespace string) (context.Context, error) {
 	if Validator == nil {
-		return status.Error(codes.Internal, "authorization token validator is not set")
+		AuthFailures.WithLabelValues(AuthFailureValidatorUnavailable).Inc()
+
+		return ctx, status.Error(codes.Internal, "authorization token validator is not set")
 	}
 
 	meta, found := metadata.FromIncomingContext(ctx)
 	if !found {
-		return status.Error(codes.Unauthenticated, "metadata is missing")
+		AuthFailures.WithLabelValues(AuthFailureMissingToken).Inc()
+
+		return ctx, status.Error(codes.Unauthenticated, "metadata is missing")
 	}
 
 	var token string
@@ -181,13 +287,78 @@ func checkAuthorizationMetadata(ctx context.Context, permission *iam.Permission)
 	}
 
 	if token == "" {
-		return status.Error(codes.Unauthenticated, "authorization header or cookie is missing")
+		AuthFailures.WithLabelValues(AuthFailureMissingToken).Inc()
+
+		return ctx, status.Error(codes.Unauthenticated, "authorization header or cookie is missing")
 	}
 
-	namespace := getNamespace()
+	namespace := reqNamespace
+	if namespace == "" {
+		// the claims are only trusted once the token is validated, a forged namespace fails the validation
+		if claims, err := parseTokenClaims(token); err == nil {
+			namespace = claims.Namespace
+		}
+	}
+	if namespace == "" {
+		namespace = getNamespace()
+	}
 	if err := Validator.Validate(token, permission, &namespace, nil); err != nil {
+		AuthFailures.WithLabelValues(validatorFailureCause(err)).Inc()
+
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	claims, err := parseTokenClaims(token)
+	if err != nil {
+		AuthFailures.WithLabelValues(AuthFailureInvalidToken).Inc()
+
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return ContextWithAuthInfo(ctx, &AuthInfo{Token: token, Claims: *claims}), nil
+}
+
+// parseTokenClaims decodes the payload of an access token, the signature must already be validated
+func parseTokenClaims(token string) (*iam.JWTClaims, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return nil, errors.New("access token is malformed")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return nil, errors.Wrap(err, "access token payload is malformed")
+	}
+
+	var claims iam.JWTClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Wrap(err, "access token claims are malformed")
+	}
+
+	return &claims, nil
+}
+
+// CheckPermission validates the caller's access token in ctx against permission, with {namespace} replaced by namespace
+func CheckPermission(ctx context.Context, permission *iam.Permission, namespace string) error {
+	if Validator == nil {
+		AuthFailures.WithLabelValues(AuthFailureValidatorUnavailable).Inc()
+
+		return status.Error(codes.Internal, "authorization token validator is not set")
+	}
+
+	authInfo, found := AuthInfoFromContext(ctx)
+	if !found {
+		AuthFailures.WithLabelValues(AuthFailureMissingToken).Inc()
+
+		return status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	if err := Validator.Validate(authInfo.Token, permission, &namespace, nil); err != nil {
+		AuthFailures.WithLabelValues(validatorFailureCause(err)).Inc()
+
 		return status.Error(codes.PermissionDenied, err.Error())
 	}
+
 	return nil
 }
 
@@ -201,7 +372,20 @@ func extractTokenFromCookieMetadata(meta metadata.MD) string {
 }
 
 func getNamespace() string {
-	return GetEnv("AB_NAMESPACE", "accelbyte")
+	return Namespace
+}
+
+// PermissionNamespace returns the namespace the permissions of a request for reqNamespace are checked in:
+// reqNamespace, else the namespace of the caller's token, else AB_NAMESPACE
+func PermissionNamespace(ctx context.Context, reqNamespace string) string {
+	if reqNamespace != "" {
+		return reqNamespace
+	}
+	if authInfo, found := AuthInfoFromContext(ctx); found && authInfo.Claims.Namespace != "" {
+		return authInfo.Claims.Namespace
+	}
+
+	return getNamespace()
 }
 
 func wrapPermission(resource string, action int) iam.Permission {
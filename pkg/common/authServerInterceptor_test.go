@@ -0,0 +1,353 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	playerResource     = "NAMESPACE:{namespace}:VIVOX:TOKEN"
+	moderationResource = "NAMESPACE:{namespace}:VIVOX:MODERATION"
+)
+
+type fakeValidator struct {
+	granted map[string]bool
+}
+
+func (v *fakeValidator) Initialize(ctx ...context.Context) error {
+	return nil
+}
+
+// Validate grants the permissions listed with {namespace} in any namespace, and the others in their own namespace
+func (v *fakeValidator) Validate(token string, permission *iam.Permission, namespace *string, userId *string) error {
+	if permission == nil || v.granted[permission.Resource] {
+		return nil
+	}
+	if namespace != nil && v.granted[strings.ReplaceAll(permission.Resource, "{namespace}", *namespace)] {
+		return nil
+	}
+
+	return fmt.Errorf("insufficient permissions for %s", permission.Resource)
+}
+
+func fakeAccessToken(payload string) string {
+	return "e30." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestExtractRequestPermissions(t *testing.T) {
+	extractor := NewProtoPermissionExtractor()
+
+	tests := []struct {
+		name string
+		req  interface{}
+		want []*iam.Permission
+	}{
+		{
+			name: "login",
+			req:  &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login},
+			want: []*iam.Permission{{Resource: playerResource, Action: int(pb.Action_CREATE)}},
+		},
+		{
+			name: "kick",
+			req:  &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			want: []*iam.Permission{{Resource: moderationResource, Action: int(pb.Action_CREATE)}},
+		},
+		{
+			name: "mute",
+			req:  &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_mute},
+			want: []*iam.Permission{{Resource: moderationResource, Action: int(pb.Action_CREATE)}},
+		},
+		{
+			name: "unknown type",
+			req:  &pb.GenerateVivoxTokenRequest{},
+			want: nil,
+		},
+		{
+			name: "not a proto message",
+			req:  "request",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractor.ExtractRequestPermissions(tt.req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtractPermission(t *testing.T) {
+	extractor := NewProtoPermissionExtractor()
+
+	tests := []struct {
+		name       string
+		fullMethod string
+		want       *iam.Permission
+	}{
+		{
+			name:       "single token",
+			fullMethod: "/service.Service/GenerateVivoxToken",
+			want:       nil,
+		},
+		{
+			name:       "batch tokens",
+			fullMethod: "/service.Service/GenerateVivoxTokens",
+			want:       &iam.Permission{Resource: playerResource, Action: int(pb.Action_CREATE)},
+		},
+		{
+			name:       "refresh token",
+			fullMethod: "/service.Service/RefreshVivoxToken",
+			want:       &iam.Permission{Resource: playerResource, Action: int(pb.Action_CREATE)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractor.ExtractPermission(&grpc.UnaryServerInfo{FullMethod: tt.fullMethod}, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// methodPermissionExtractor only reads the permissions of the methods, not of the request values
+type methodPermissionExtractor struct {
+	extractor *ProtoPermissionExtractorImpl
+}
+
+func (e methodPermissionExtractor) ExtractPermission(infoUnary *grpc.UnaryServerInfo, infoStream *grpc.StreamServerInfo) (*iam.Permission, error) {
+	return e.extractor.ExtractPermission(infoUnary, infoStream)
+}
+
+func TestUnaryAuthServerIntercept(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/service.Service/GenerateVivoxToken"}
+	token := fakeAccessToken(`{"sub":"beef","namespace":"accelbyte","client_id":"gameclient"}`)
+
+	tests := []struct {
+		name        string
+		md          metadata.MD
+		extractor   ProtoPermissionExtractor
+		granted     map[string]bool
+		req         *pb.GenerateVivoxTokenRequest
+		wantCode    codes.Code
+		wantUserID  string
+		wantHandled bool
+	}{
+		{
+			name:        "player requests join token",
+			md:          metadata.Pairs("authorization", "Bearer "+token),
+			granted:     map[string]bool{playerResource: true},
+			req:         &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_join},
+			wantCode:    codes.OK,
+			wantUserID:  "beef",
+			wantHandled: true,
+		},
+		{
+			name:     "player requests kick token",
+			md:       metadata.Pairs("authorization", "Bearer "+token),
+			granted:  map[string]bool{playerResource: true},
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:        "moderator requests kick token",
+			md:          metadata.Pairs("authorization", "Bearer "+token),
+			granted:     map[string]bool{playerResource: true, moderationResource: true},
+			req:         &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			wantCode:    codes.OK,
+			wantUserID:  "beef",
+			wantHandled: true,
+		},
+		{
+			name:        "extractor without request permissions",
+			md:          metadata.Pairs("authorization", "Bearer "+token),
+			extractor:   methodPermissionExtractor{extractor: NewProtoPermissionExtractor()},
+			granted:     map[string]bool{playerResource: true},
+			req:         &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			wantCode:    codes.OK,
+			wantUserID:  "beef",
+			wantHandled: true,
+		},
+		{
+			name:        "player requests token in own namespace",
+			md:          metadata.Pairs("authorization", "Bearer "+token),
+			granted:     map[string]bool{"NAMESPACE:accelbyte:VIVOX:TOKEN": true},
+			req:         &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_join},
+			wantCode:    codes.OK,
+			wantUserID:  "beef",
+			wantHandled: true,
+		},
+		{
+			name:     "player requests token in another namespace",
+			md:       metadata.Pairs("authorization", "Bearer "+token),
+			granted:  map[string]bool{"NAMESPACE:accelbyte:VIVOX:TOKEN": true},
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_join, Namespace: "othergame"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "token without permission",
+			md:       metadata.Pairs("authorization", "Bearer "+token),
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:        "token from cookie",
+			md:          metadata.Pairs("cookie", "access_token="+token),
+			granted:     map[string]bool{playerResource: true},
+			req:         &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login},
+			wantCode:    codes.OK,
+			wantUserID:  "beef",
+			wantHandled: true,
+		},
+		{
+			name:     "missing token",
+			md:       metadata.MD{},
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "missing metadata",
+			md:       nil,
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			original := Validator
+			Validator = &fakeValidator{granted: tt.granted}
+			defer func() { Validator = original }()
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			handled := false
+			var userID string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				if authInfo, found := AuthInfoFromContext(ctx); found {
+					userID = authInfo.UserID()
+				}
+
+				return nil, nil
+			}
+			var extractor ProtoPermissionExtractor = NewProtoPermissionExtractor()
+			if tt.extractor != nil {
+				extractor = tt.extractor
+			}
+			intercept := NewUnaryAuthServerIntercept(extractor)
+
+			// when
+			_, err := intercept(ctx, tt.req, info, handler)
+
+			// then
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantHandled, handled)
+			assert.Equal(t, tt.wantUserID, userID)
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *pb.GenerateVivoxTokenRequest
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+
+	return nil
+}
+
+func TestStreamAuthServerIntercept(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/service.Service/RefreshVivoxToken", IsServerStream: true}
+	token := fakeAccessToken(`{"sub":"beef","namespace":"accelbyte","client_id":"gameclient"}`)
+
+	tests := []struct {
+		name       string
+		granted    map[string]bool
+		req        *pb.GenerateVivoxTokenRequest
+		wantCode   codes.Code
+		wantUserID string
+	}{
+		{
+			name:       "player refreshes join token",
+			granted:    map[string]bool{playerResource: true},
+			req:        &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_join},
+			wantCode:   codes.OK,
+			wantUserID: "beef",
+		},
+		{
+			name:     "player refreshes kick token",
+			granted:  map[string]bool{playerResource: true},
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "moderator without token permission",
+			granted:  map[string]bool{moderationResource: true},
+			req:      &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			original := Validator
+			Validator = &fakeValidator{granted: tt.granted}
+			defer func() { Validator = original }()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+			stream := &fakeServerStream{ctx: ctx, req: tt.req}
+
+			var userID string
+			handler := func(srv interface{}, ss grpc.ServerStream) error {
+				var req pb.GenerateVivoxTokenRequest
+				if err := ss.RecvMsg(&req); err != nil {
+					return err
+				}
+				if authInfo, found := AuthInfoFromContext(ss.Context()); found {
+					userID = authInfo.UserID()
+				}
+
+				return nil
+			}
+			intercept := NewStreamAuthServerIntercept(NewProtoPermissionExtractor())
+
+			// when
+			err := intercept(nil, stream, info, handler)
+
+			// then
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantUserID, userID)
+		})
+	}
+}
@@ -4,4 +4,607 @@
 
 package common
 
-var BasePath = getBasePath()
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+
+	"gopkg.in/yaml.v3"
+)
+
+// set from Config at startup, used by the gateway and the auth interceptors
+var (
+	BasePath  = "/vivoxauth"
+	Namespace = "accelbyte"
+)
+
+const (
+	SerialSourceRandom    = "random"
+	SerialSourceMonotonic = "monotonic"
+
+	TTLOutOfBoundsReject = "reject"
+	TTLOutOfBoundsClamp  = "clamp"
+
+	RateLimitScopeUser      = "user"
+	RateLimitScopeClient    = "client"
+	RateLimitScopeNamespace = "namespace"
+
+	// IAMBanAllActions in IAMBanActions denies every action
+	IAMBanAllActions = "*"
+
+	ChannelAuthorizationNone    = "none"
+	ChannelAuthorizationSession = "session"
+)
+
+// RateLimitScopes are the keys of the token buckets of each action: the caller's user ID, client ID and namespace
+var RateLimitScopes = []string{RateLimitScopeUser, RateLimitScopeClient, RateLimitScopeNamespace}
+
+// Config is the app configuration, loaded from an optional YAML file overridden by environment variables
+type Config struct {
+	LogLevel        string      `yaml:"logLevel"`        // LOG_LEVEL
+	BasePath        string      `yaml:"basePath"`        // BASE_PATH
+	Namespace       string      `yaml:"namespace"`       // AB_NAMESPACE
+	AuthEnabled     bool        `yaml:"authEnabled"`     // PLUGIN_GRPC_SERVER_AUTH_ENABLED
+	RefreshInterval int         `yaml:"refreshInterval"` // REFRESH_INTERVAL, seconds
+	Vivox           VivoxConfig `yaml:"vivox"`
+	Audit           AuditConfig `yaml:"audit"`
+}
+
+// AuditConfig is the configuration of the audit log of issued tokens
+type AuditConfig struct {
+	Enabled  bool     `yaml:"enabled"`  // AUDIT_ENABLED
+	Actions  []string `yaml:"actions"`  // AUDIT_ACTIONS, comma separated action types, kick and mute are required
+	File     string   `yaml:"file"`     // AUDIT_FILE
+	MaxSize  int      `yaml:"maxSize"`  // AUDIT_MAX_SIZE, megabytes before the file is rotated
+	MaxFiles int      `yaml:"maxFiles"` // AUDIT_MAX_FILES, rotated files kept
+	HMACKey  string   `yaml:"hmacKey"`  // AUDIT_HMAC_KEY, chains the entries with an HMAC instead of a plain SHA-256
+}
+
+// VivoxConfig is the configuration of the Vivox tokens
+type VivoxConfig struct {
+	Issuer                  string   `yaml:"issuer"`                  // VIVOX_ISSUER
+	Domain                  string   `yaml:"domain"`                  // VIVOX_DOMAIN
+	SigningKey              string   `yaml:"signingKey"`              // VIVOX_SIGNING_KEY
+	SigningKeyFile          string   `yaml:"signingKeyFile"`          // VIVOX_SIGNING_KEY_FILE
+	SigningKeyReloadPeriod  int      `yaml:"signingKeyReloadPeriod"`  // VIVOX_SIGNING_KEY_RELOAD_PERIOD, seconds
+	TenantsFile             string   `yaml:"tenantsFile"`             // VIVOX_TENANTS_FILE
+	DefaultExpiry           int      `yaml:"defaultExpiry"`           // VIVOX_DEFAULT_EXPIRY, seconds
+	Protocol                string   `yaml:"protocol"`                // VIVOX_PROTOCOL
+	ChannelPrefix           string   `yaml:"channelPrefix"`           // VIVOX_CHANNEL_PREFIX
+	TranscriptionNamespaces []string `yaml:"transcriptionNamespaces"` // VIVOX_TRANSCRIPTION_NAMESPACES, comma separated
+	AdminPermissionResource string   `yaml:"adminPermissionResource"` // VIVOX_ADMIN_PERMISSION_RESOURCE
+	AdminPermissionAction   int      `yaml:"adminPermissionAction"`   // VIVOX_ADMIN_PERMISSION_ACTION
+	SerialSource            string   `yaml:"serialSource"`            // VIVOX_SERIAL_SOURCE
+	RefreshLead             int      `yaml:"refreshLead"`             // VIVOX_REFRESH_LEAD, seconds before expiry a refreshed token is pushed
+	IDEncoding              string   `yaml:"idEncoding"`              // VIVOX_ID_ENCODING, none, percent or hash
+	BansFile                string   `yaml:"bansFile"`                // VIVOX_BANS_FILE, voice bans are kept in memory when empty
+	ChannelAuthorization    string   `yaml:"channelAuthorization"`    // VIVOX_CHANNEL_AUTHORIZATION, none or session
+
+	// token lifetime bounds keyed by action type, e.g. kick
+	TTLs           map[string]TTLPolicy `yaml:"ttls"`           // VIVOX_TTL_<ACTION>, e.g. VIVOX_TTL_KICK='min=5,default=10,max=30'
+	TTLOutOfBounds string               `yaml:"ttlOutOfBounds"` // VIVOX_TTL_OUT_OF_BOUNDS, reject or clamp
+
+	// token issuance limits keyed by action type then scope, e.g. kick then user
+	RateLimitEnabled bool                            `yaml:"rateLimitEnabled"` // VIVOX_RATE_LIMIT_ENABLED
+	RateLimits       map[string]map[string]RateLimit `yaml:"rateLimits"`       // VIVOX_RATE_LIMIT_<ACTION>_<SCOPE>, e.g. VIVOX_RATE_LIMIT_KICK_USER='perMinute=6,burst=2'
+
+	// action types denied to users with an active AccelByte IAM ban, keyed by ban type, e.g. CHAT_SEND.
+	// Without the lookup only the caller's own bans are known: tokens requested on behalf of a user, e.g. by a game
+	// server, are issued whatever the bans of that user.
+	IAMBansEnabled bool                `yaml:"iamBansEnabled"` // VIVOX_IAM_BANS_ENABLED, check the bans of the caller's access token
+	IAMBansLookup  bool                `yaml:"iamBansLookup"`  // VIVOX_IAM_BANS_LOOKUP, also query IAM for the current bans of the user
+	IAMBanActions  map[string][]string `yaml:"iamBanActions"`  // VIVOX_IAM_BAN_ACTIONS, e.g. 'CHAT_ALL=*;CHAT_SEND=join,kick,mute'
+
+	// channel types of the voice channels of AccelByte sessions, keyed by session template name or kind, game or party
+	SessionChannelTypes map[string]string `yaml:"sessionChannelTypes"` // VIVOX_SESSION_CHANNEL_TYPES, e.g. 'party=nonpositional,game=positional'
+}
+
+// SessionChannelType returns the channel type of the sessions created from template, falling back to the type of
+// the kind of session
+func (v VivoxConfig) SessionChannelType(template, kind string) string {
+	if channelType, found := v.SessionChannelTypes[template]; found && template != "" {
+		return channelType
+	}
+
+	return v.SessionChannelTypes[kind]
+}
+
+// RateLimit is a token bucket refilled with PerMinute tokens a minute and holding up to Burst tokens.
+// A PerMinute of 0 does not limit, a Burst of 0 holds a single token.
+type RateLimit struct {
+	PerMinute int `yaml:"perMinute"`
+	Burst     int `yaml:"burst"`
+}
+
+// TTLPolicy bounds the lifetime in seconds of the tokens of an action, unset values fall back to DefaultExpiry
+type TTLPolicy struct {
+	Min     int `yaml:"min"`
+	Default int `yaml:"default"`
+	Max     int `yaml:"max"`
+}
+
+// TTLPolicy returns the lifetime bounds of action. Without configured bounds, tokens last DefaultExpiry seconds.
+func (v VivoxConfig) TTLPolicy(action string) TTLPolicy {
+	policy := v.TTLs[action]
+	if policy.Default == 0 {
+		policy.Default = v.DefaultExpiry
+	}
+	if policy.Min == 0 {
+		policy.Min = 1
+	}
+	if policy.Max == 0 {
+		policy.Max = policy.Default
+	}
+
+	return policy
+}
+
+// tokenActions are the action types of GenerateVivoxTokenRequest
+func tokenActions() []string {
+	var actions []string
+	for value := range pb.GenerateVivoxTokenRequestType_name {
+		if value != int32(pb.GenerateVivoxTokenRequestType_generatevivoxtokenrequest_type_unknown) {
+			actions = append(actions, pb.GenerateVivoxTokenRequestType(value).String())
+		}
+	}
+	slices.Sort(actions)
+
+	return actions
+}
+
+// DefaultConfig returns the configuration used for values set neither in the file nor the environment
+func DefaultConfig() Config {
+	return Config{
+		LogLevel:        "info",
+		BasePath:        "/vivoxauth",
+		Namespace:       "accelbyte",
+		AuthEnabled:     true,
+		RefreshInterval: 600,
+		Vivox: VivoxConfig{
+			SigningKeyReloadPeriod:  30,
+			DefaultExpiry:           90,
+			Protocol:                "sip",
+			ChannelPrefix:           "confctl",
+			AdminPermissionResource: "ADMIN:NAMESPACE:{namespace}:VIVOX:TOKEN",
+			AdminPermissionAction:   int(pb.Action_CREATE),
+			SerialSource:            SerialSourceRandom,
+			RefreshLead:             10,
+			IDEncoding:              string(vivox.IDEncodingNone),
+			ChannelAuthorization:    ChannelAuthorizationNone,
+			TTLOutOfBounds:          TTLOutOfBoundsReject,
+			RateLimitEnabled:        true,
+			RateLimits: map[string]map[string]RateLimit{
+				pb.GenerateVivoxTokenRequestType_login.String():         {RateLimitScopeUser: {PerMinute: 30, Burst: 10}},
+				pb.GenerateVivoxTokenRequestType_join.String():          {RateLimitScopeUser: {PerMinute: 60, Burst: 20}},
+				pb.GenerateVivoxTokenRequestType_join_muted.String():    {RateLimitScopeUser: {PerMinute: 60, Burst: 20}},
+				pb.GenerateVivoxTokenRequestType_kick.String():          {RateLimitScopeUser: {PerMinute: 6, Burst: 2}},
+				pb.GenerateVivoxTokenRequestType_mute.String():          {RateLimitScopeUser: {PerMinute: 20, Burst: 5}},
+				pb.GenerateVivoxTokenRequestType_transcription.String(): {RateLimitScopeUser: {PerMinute: 10, Burst: 5}},
+			},
+			IAMBansEnabled: true,
+			IAMBanActions: map[string][]string{
+				"LOGIN":    {IAMBanAllActions},
+				"CHAT_ALL": {IAMBanAllActions},
+				// join_muted is left to let the user listen, transcription would turn their speech into text
+				"CHAT_SEND": {
+					pb.GenerateVivoxTokenRequestType_join.String(),
+					pb.GenerateVivoxTokenRequestType_kick.String(),
+					pb.GenerateVivoxTokenRequestType_mute.String(),
+					pb.GenerateVivoxTokenRequestType_transcription.String(),
+				},
+			},
+			SessionChannelTypes: map[string]string{
+				"party": pb.GenerateVivoxTokenRequestChannelType_nonpositional.String(),
+				"game":  pb.GenerateVivoxTokenRequestChannelType_positional.String(),
+			},
+		},
+		Audit: AuditConfig{
+			Enabled:  true,
+			Actions:  []string{pb.GenerateVivoxTokenRequestType_kick.String(), pb.GenerateVivoxTokenRequestType_mute.String()},
+			File:     "audit/vivox-tokens.jsonl",
+			MaxSize:  100,
+			MaxFiles: 10,
+		},
+	}
+}
+
+// LoadConfig reads the YAML file at path, if any, applies the environment and validates the result.
+// All invalid values are reported in the returned error.
+func LoadConfig(path string) (*Config, error) {
+	config, envErrs, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = errors.Join(append(envErrs, config.Validate())...); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// ReadConfig reads the YAML file at path, if any, and applies the environment without validating the result
+func ReadConfig(path string) (*Config, error) {
+	config, envErrs, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = errors.Join(envErrs...); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// readConfig returns the configuration with the errors of the environment variables that could not be parsed
+func readConfig(path string) (Config, []error, error) {
+	config := DefaultConfig()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return config, nil, fmt.Errorf("read config file: %w", err)
+		}
+		if err = yaml.Unmarshal(data, &config); err != nil {
+			return config, nil, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	env := envReader{}
+	env.string("LOG_LEVEL", &config.LogLevel)
+	env.string("BASE_PATH", &config.BasePath)
+	env.string("AB_NAMESPACE", &config.Namespace)
+	env.bool("PLUGIN_GRPC_SERVER_AUTH_ENABLED", &config.AuthEnabled)
+	env.int("REFRESH_INTERVAL", &config.RefreshInterval)
+	env.string("VIVOX_ISSUER", &config.Vivox.Issuer)
+	env.string("VIVOX_DOMAIN", &config.Vivox.Domain)
+	env.string("VIVOX_SIGNING_KEY", &config.Vivox.SigningKey)
+	env.string("VIVOX_SIGNING_KEY_FILE", &config.Vivox.SigningKeyFile)
+	env.int("VIVOX_SIGNING_KEY_RELOAD_PERIOD", &config.Vivox.SigningKeyReloadPeriod)
+	env.string("VIVOX_TENANTS_FILE", &config.Vivox.TenantsFile)
+	env.int("VIVOX_DEFAULT_EXPIRY", &config.Vivox.DefaultExpiry)
+	env.string("VIVOX_PROTOCOL", &config.Vivox.Protocol)
+	env.string("VIVOX_CHANNEL_PREFIX", &config.Vivox.ChannelPrefix)
+	env.list("VIVOX_TRANSCRIPTION_NAMESPACES", &config.Vivox.TranscriptionNamespaces)
+	env.string("VIVOX_ADMIN_PERMISSION_RESOURCE", &config.Vivox.AdminPermissionResource)
+	env.int("VIVOX_ADMIN_PERMISSION_ACTION", &config.Vivox.AdminPermissionAction)
+	env.string("VIVOX_SERIAL_SOURCE", &config.Vivox.SerialSource)
+	env.int("VIVOX_REFRESH_LEAD", &config.Vivox.RefreshLead)
+	env.string("VIVOX_ID_ENCODING", &config.Vivox.IDEncoding)
+	env.string("VIVOX_BANS_FILE", &config.Vivox.BansFile)
+	env.string("VIVOX_CHANNEL_AUTHORIZATION", &config.Vivox.ChannelAuthorization)
+	for _, action := range tokenActions() {
+		env.ttlPolicy("VIVOX_TTL_"+strings.ToUpper(action), action, &config.Vivox.TTLs)
+	}
+	env.string("VIVOX_TTL_OUT_OF_BOUNDS", &config.Vivox.TTLOutOfBounds)
+	env.bool("VIVOX_IAM_BANS_ENABLED", &config.Vivox.IAMBansEnabled)
+	env.bool("VIVOX_IAM_BANS_LOOKUP", &config.Vivox.IAMBansLookup)
+	env.banActions("VIVOX_IAM_BAN_ACTIONS", &config.Vivox.IAMBanActions)
+	env.sessionChannelTypes("VIVOX_SESSION_CHANNEL_TYPES", &config.Vivox.SessionChannelTypes)
+	env.bool("VIVOX_RATE_LIMIT_ENABLED", &config.Vivox.RateLimitEnabled)
+	for _, action := range tokenActions() {
+		for _, scope := range RateLimitScopes {
+			env.rateLimit("VIVOX_RATE_LIMIT_"+strings.ToUpper(action+"_"+scope), action, scope, &config.Vivox.RateLimits)
+		}
+	}
+
+	env.bool("AUDIT_ENABLED", &config.Audit.Enabled)
+	env.list("AUDIT_ACTIONS", &config.Audit.Actions)
+	env.string("AUDIT_FILE", &config.Audit.File)
+	env.int("AUDIT_MAX_SIZE", &config.Audit.MaxSize)
+	env.int("AUDIT_MAX_FILES", &config.Audit.MaxFiles)
+	env.string("AUDIT_HMAC_KEY", &config.Audit.HMACKey)
+
+	return config, env.errs, nil
+}
+
+// Validate returns every invalid value of the configuration
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch strings.ToLower(c.LogLevel) {
+	case "debug", "info", "warn", "warning", "error", "fatal", "panic":
+	default:
+		invalid("LOG_LEVEL %q is not one of debug, info, warn or error", c.LogLevel)
+	}
+	if !strings.HasPrefix(c.BasePath, "/") {
+		invalid("BASE_PATH %q has no leading '/', valid example: /basePath", c.BasePath)
+	}
+	if c.Namespace == "" {
+		invalid("AB_NAMESPACE is required")
+	}
+	if c.AuthEnabled && c.RefreshInterval <= 0 {
+		invalid("REFRESH_INTERVAL must be greater than 0")
+	}
+
+	v := c.Vivox
+	if v.TenantsFile == "" {
+		if v.Issuer == "" {
+			invalid("VIVOX_ISSUER is required")
+		}
+		if v.Domain == "" {
+			invalid("VIVOX_DOMAIN is required")
+		}
+		if v.SigningKey == "" && v.SigningKeyFile == "" {
+			invalid("VIVOX_SIGNING_KEY or VIVOX_SIGNING_KEY_FILE is required")
+		}
+	}
+	if v.SigningKeyReloadPeriod < 0 {
+		invalid("VIVOX_SIGNING_KEY_RELOAD_PERIOD must not be negative")
+	}
+	if v.DefaultExpiry <= 0 {
+		invalid("VIVOX_DEFAULT_EXPIRY must be greater than 0")
+	}
+	if v.Protocol == "" {
+		invalid("VIVOX_PROTOCOL is required")
+	}
+	if v.ChannelPrefix == "" {
+		invalid("VIVOX_CHANNEL_PREFIX is required")
+	}
+	if v.AdminPermissionResource == "" {
+		invalid("VIVOX_ADMIN_PERMISSION_RESOURCE is required")
+	}
+	if v.AdminPermissionAction <= 0 || v.AdminPermissionAction > 15 {
+		invalid("VIVOX_ADMIN_PERMISSION_ACTION %d is not a combination of CREATE (1), READ (2), UPDATE (4) and DELETE (8)", v.AdminPermissionAction)
+	}
+	switch v.SerialSource {
+	case SerialSourceRandom, SerialSourceMonotonic:
+	default:
+		invalid("VIVOX_SERIAL_SOURCE %q is not one of %s or %s", v.SerialSource, SerialSourceRandom, SerialSourceMonotonic)
+	}
+
+	if v.RefreshLead < 0 {
+		invalid("VIVOX_REFRESH_LEAD must not be negative")
+	}
+	if !slices.Contains(vivox.IDEncodings, vivox.IDEncoding(v.IDEncoding)) {
+		invalid("VIVOX_ID_ENCODING %q is not one of %s, %s or %s", v.IDEncoding, vivox.IDEncodingNone, vivox.IDEncodingPercent, vivox.IDEncodingHash)
+	}
+	switch v.ChannelAuthorization {
+	case ChannelAuthorizationNone, ChannelAuthorizationSession:
+	default:
+		invalid("VIVOX_CHANNEL_AUTHORIZATION %q is not one of %s or %s", v.ChannelAuthorization, ChannelAuthorizationNone, ChannelAuthorizationSession)
+	}
+	actions := tokenActions()
+	for action := range v.TTLs {
+		if !slices.Contains(actions, action) {
+			invalid("vivox.ttls action %q is not one of %s", action, strings.Join(actions, ", "))
+		}
+	}
+	for _, action := range actions {
+		if policy := v.TTLPolicy(action); policy.Min <= 0 || policy.Min > policy.Default || policy.Default > policy.Max {
+			invalid("VIVOX_TTL_%s must satisfy 0 < min <= default <= max, got min=%d,default=%d,max=%d",
+				strings.ToUpper(action), policy.Min, policy.Default, policy.Max)
+		}
+	}
+	switch v.TTLOutOfBounds {
+	case TTLOutOfBoundsReject, TTLOutOfBoundsClamp:
+	default:
+		invalid("VIVOX_TTL_OUT_OF_BOUNDS %q is not one of %s or %s", v.TTLOutOfBounds, TTLOutOfBoundsReject, TTLOutOfBoundsClamp)
+	}
+	for action, scopes := range v.RateLimits {
+		if !slices.Contains(actions, action) {
+			invalid("vivox.rateLimits action %q is not one of %s", action, strings.Join(actions, ", "))
+		}
+		for scope, limit := range scopes {
+			if !slices.Contains(RateLimitScopes, scope) {
+				invalid("vivox.rateLimits.%s scope %q is not one of %s", action, scope, strings.Join(RateLimitScopes, ", "))
+			}
+			if limit.PerMinute < 0 || limit.Burst < 0 {
+				invalid("VIVOX_RATE_LIMIT_%s_%s must not be negative, got perMinute=%d,burst=%d",
+					strings.ToUpper(action), strings.ToUpper(scope), limit.PerMinute, limit.Burst)
+			}
+		}
+	}
+	for ban, banActions := range v.IAMBanActions {
+		if ban == "" {
+			invalid("vivox.iamBanActions ban type is required")
+		}
+		for _, action := range banActions {
+			if action != IAMBanAllActions && !slices.Contains(actions, action) {
+				invalid("VIVOX_IAM_BAN_ACTIONS %s action %q is not %s or one of %s", ban, action, IAMBanAllActions, strings.Join(actions, ", "))
+			}
+		}
+	}
+	for session, channelType := range v.SessionChannelTypes {
+		if value, found := pb.GenerateVivoxTokenRequestChannelType_value[channelType]; !found ||
+			value == int32(pb.GenerateVivoxTokenRequestChannelType_generatevivoxtokenrequest_channeltype_unknown) {
+			invalid("VIVOX_SESSION_CHANNEL_TYPES %s channel type %q is not one of echo, nonpositional or positional", session, channelType)
+		}
+	}
+
+	a := c.Audit
+	if a.Enabled {
+		for _, action := range a.Actions {
+			if !slices.Contains(actions, action) {
+				invalid("AUDIT_ACTIONS action %q is not one of %s", action, strings.Join(actions, ", "))
+			}
+		}
+		// only join and login are opt-in, kick and mute tokens are always recorded
+		if !slices.Contains(a.Actions, pb.GenerateVivoxTokenRequestType_kick.String()) ||
+			!slices.Contains(a.Actions, pb.GenerateVivoxTokenRequestType_mute.String()) {
+			invalid("AUDIT_ACTIONS must include kick and mute")
+		}
+		if a.File == "" {
+			invalid("AUDIT_FILE is required")
+		}
+		if a.MaxSize <= 0 {
+			invalid("AUDIT_MAX_SIZE must be greater than 0")
+		}
+		if a.MaxFiles < 0 {
+			invalid("AUDIT_MAX_FILES must not be negative")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// envReader overrides configuration values with the environment variables that are set, collecting parse errors
+type envReader struct {
+	errs []error
+}
+
+func (e *envReader) string(key string, value *string) {
+	if str, ok := os.LookupEnv(key); ok {
+		*value = strings.Trim(str, "\"")
+	}
+}
+
+func (e *envReader) int(key string, value *int) {
+	var str string
+	if e.string(key, &str); str == "" {
+		return
+	}
+	val, err := strconv.Atoi(str)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s %q is not an integer", key, str))
+
+		return
+	}
+	*value = val
+}
+
+func (e *envReader) bool(key string, value *bool) {
+	var str string
+	if e.string(key, &str); str == "" {
+		return
+	}
+	*value = strings.ToLower(str) == "true"
+}
+
+func (e *envReader) list(key string, value *[]string) {
+	str, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+
+	var items []string
+	for _, item := range strings.Split(strings.Trim(str, "\""), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*value = items
+}
+
+// ttlPolicy reads a policy formatted as min=5,default=10,max=30, where each bound is optional
+func (e *envReader) ttlPolicy(key, action string, value *map[string]TTLPolicy) {
+	policy := (*value)[action]
+	found := e.fields(key, "min=5,default=10,max=30", map[string]*int{
+		"min":     &policy.Min,
+		"default": &policy.Default,
+		"max":     &policy.Max,
+	})
+	if !found {
+		return
+	}
+
+	if *value == nil {
+		*value = make(map[string]TTLPolicy)
+	}
+	(*value)[action] = policy
+}
+
+// rateLimit reads a limit formatted as perMinute=6,burst=2, where each field is optional
+func (e *envReader) rateLimit(key, action, scope string, value *map[string]map[string]RateLimit) {
+	limit := (*value)[action][scope]
+	found := e.fields(key, "perMinute=6,burst=2", map[string]*int{
+		"perMinute": &limit.PerMinute,
+		"burst":     &limit.Burst,
+	})
+	if !found {
+		return
+	}
+
+	if *value == nil {
+		*value = make(map[string]map[string]RateLimit)
+	}
+	if (*value)[action] == nil {
+		(*value)[action] = make(map[string]RateLimit)
+	}
+	(*value)[action][scope] = limit
+}
+
+// banActions reads the action types per ban type formatted as CHAT_ALL=*;CHAT_SEND=join,kick,mute, replacing all defaults
+func (e *envReader) banActions(key string, value *map[string][]string) {
+	var str string
+	if e.string(key, &str); str == "" {
+		return
+	}
+
+	banActions := make(map[string][]string)
+	for _, entry := range strings.Split(str, ";") {
+		ban, list, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if ban = strings.TrimSpace(ban); !found || ban == "" {
+			e.errs = append(e.errs, fmt.Errorf("%s %q is not formatted as CHAT_ALL=*;CHAT_SEND=join,kick,mute", key, str))
+
+			return
+		}
+		actions := []string{}
+		for _, action := range strings.Split(list, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				actions = append(actions, action)
+			}
+		}
+		banActions[ban] = actions
+	}
+	*value = banActions
+}
+
+// sessionChannelTypes reads the channel type per session template or kind formatted as party=nonpositional,game=positional,
+// merged over the defaults
+func (e *envReader) sessionChannelTypes(key string, value *map[string]string) {
+	var str string
+	if e.string(key, &str); str == "" {
+		return
+	}
+
+	channelTypes := maps.Clone(*value)
+	if channelTypes == nil {
+		channelTypes = make(map[string]string)
+	}
+	for _, entry := range strings.Split(str, ",") {
+		session, channelType, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if session, channelType = strings.TrimSpace(session), strings.TrimSpace(channelType); !found || session == "" {
+			e.errs = append(e.errs, fmt.Errorf("%s %q is not formatted as party=nonpositional,game=positional", key, str))
+
+			return
+		}
+		channelTypes[session] = channelType
+	}
+	*value = channelTypes
+}
+
+// fields reads the integer fields of a variable formatted as example, e.g. a=1,b=2.
+// It reports whether the variable is set and well formed, the fields are only assigned then.
+func (e *envReader) fields(key, example string, fields map[string]*int) bool {
+	var str string
+	if e.string(key, &str); str == "" {
+		return false
+	}
+
+	values := make(map[string]int)
+	for _, field := range strings.Split(str, ",") {
+		name, number, found := strings.Cut(strings.TrimSpace(field), "=")
+		val, err := strconv.Atoi(strings.TrimSpace(number))
+		_, known := fields[strings.TrimSpace(name)]
+		if !found || err != nil || !known {
+			e.errs = append(e.errs, fmt.Errorf("%s %q is not formatted as %s", key, str, example))
+
+			return false
+		}
+		values[strings.TrimSpace(name)] = val
+	}
+	for name, val := range values {
+		*fields[name] = val
+	}
+
+	return true
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearConfigEnv unsets the configuration environment variables for the duration of the test
+func clearConfigEnv(t *testing.T) {
+	keys := []string{
+		"LOG_LEVEL", "BASE_PATH", "AB_NAMESPACE", "PLUGIN_GRPC_SERVER_AUTH_ENABLED", "REFRESH_INTERVAL",
+		"VIVOX_ISSUER", "VIVOX_DOMAIN", "VIVOX_SIGNING_KEY", "VIVOX_SIGNING_KEY_FILE", "VIVOX_SIGNING_KEY_RELOAD_PERIOD",
+		"VIVOX_TENANTS_FILE", "VIVOX_DEFAULT_EXPIRY", "VIVOX_PROTOCOL", "VIVOX_CHANNEL_PREFIX",
+		"VIVOX_TRANSCRIPTION_NAMESPACES", "VIVOX_ADMIN_PERMISSION_RESOURCE", "VIVOX_ADMIN_PERMISSION_ACTION",
+		"VIVOX_SERIAL_SOURCE", "VIVOX_REFRESH_LEAD", "VIVOX_ID_ENCODING", "VIVOX_BANS_FILE", "VIVOX_CHANNEL_AUTHORIZATION", "VIVOX_TTL_OUT_OF_BOUNDS", "VIVOX_TTL_LOGIN", "VIVOX_TTL_JOIN", "VIVOX_TTL_JOIN_MUTED",
+		"VIVOX_TTL_KICK", "VIVOX_TTL_MUTE", "VIVOX_TTL_TRANSCRIPTION", "VIVOX_RATE_LIMIT_ENABLED",
+		"VIVOX_IAM_BANS_ENABLED", "VIVOX_IAM_BANS_LOOKUP", "VIVOX_IAM_BAN_ACTIONS", "VIVOX_SESSION_CHANNEL_TYPES",
+		"AUDIT_ENABLED", "AUDIT_ACTIONS", "AUDIT_FILE", "AUDIT_MAX_SIZE", "AUDIT_MAX_FILES", "AUDIT_HMAC_KEY",
+	}
+	for _, action := range tokenActions() {
+		for _, scope := range RateLimitScopes {
+			keys = append(keys, "VIVOX_RATE_LIMIT_"+strings.ToUpper(action+"_"+scope))
+		}
+	}
+	for _, key := range keys {
+		if value, ok := os.LookupEnv(key); ok {
+			require.NoError(t, os.Unsetenv(key))
+			t.Cleanup(func() { _ = os.Setenv(key, value) })
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	clearConfigEnv(t)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+namespace: publisher
+vivox:
+  issuer: file-issuer
+  domain: tla.vivox.com
+  signingKey: file-key
+  defaultExpiry: 120
+  transcriptionNamespaces: [game1]
+  rateLimits:
+    kick:
+      client: {perMinute: 60, burst: 10}
+`), 0o600))
+	t.Setenv("VIVOX_ISSUER", "env-issuer")
+	t.Setenv("VIVOX_TRANSCRIPTION_NAMESPACES", "game1, game2")
+	t.Setenv("VIVOX_TTL_KICK", "min=5, default=10, max=30")
+	t.Setenv("VIVOX_RATE_LIMIT_KICK_USER", "perMinute=3")
+	t.Setenv("VIVOX_IAM_BAN_ACTIONS", "CHAT_ALL=*; CHAT_SEND=join, join_muted")
+	t.Setenv("VIVOX_SESSION_CHANNEL_TYPES", "game=nonpositional, arena=positional")
+	t.Setenv("AUDIT_ACTIONS", "kick,mute,join")
+
+	config, err := LoadConfig(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "publisher", config.Namespace)
+	assert.Equal(t, "env-issuer", config.Vivox.Issuer)
+	assert.Equal(t, "file-key", config.Vivox.SigningKey)
+	assert.Equal(t, 120, config.Vivox.DefaultExpiry)
+	assert.Equal(t, []string{"game1", "game2"}, config.Vivox.TranscriptionNamespaces)
+	assert.Equal(t, "sip", config.Vivox.Protocol)
+	assert.True(t, config.AuthEnabled)
+	assert.Equal(t, TTLPolicy{Min: 5, Default: 10, Max: 30}, config.Vivox.TTLPolicy("kick"))
+	assert.Equal(t, TTLPolicy{Min: 1, Default: 120, Max: 120}, config.Vivox.TTLPolicy("login"))
+	assert.True(t, config.Vivox.RateLimitEnabled)
+	assert.Equal(t, map[string]RateLimit{
+		RateLimitScopeUser:   {PerMinute: 3},
+		RateLimitScopeClient: {PerMinute: 60, Burst: 10},
+	}, config.Vivox.RateLimits["kick"])
+	assert.Equal(t, DefaultConfig().Vivox.RateLimits["login"], config.Vivox.RateLimits["login"])
+	assert.True(t, config.Vivox.IAMBansEnabled)
+	assert.False(t, config.Vivox.IAMBansLookup)
+	assert.Equal(t, map[string][]string{"CHAT_ALL": {"*"}, "CHAT_SEND": {"join", "join_muted"}}, config.Vivox.IAMBanActions)
+	assert.Equal(t, "positional", config.Vivox.SessionChannelType("arena", "game"))
+	assert.Equal(t, "nonpositional", config.Vivox.SessionChannelType("deathmatch", "game"))
+	assert.Equal(t, "nonpositional", config.Vivox.SessionChannelType("", "party"), "the defaults are kept")
+	assert.Equal(t, []string{"kick", "mute", "join"}, config.Audit.Actions)
+	assert.Equal(t, "audit/vivox-tokens.jsonl", config.Audit.File)
+	assert.True(t, config.Audit.Enabled)
+}
+
+func TestLoadConfigReportsAllErrors(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("BASE_PATH", "vivoxauth")
+	t.Setenv("VIVOX_DEFAULT_EXPIRY", "soon")
+	t.Setenv("VIVOX_SERIAL_SOURCE", "sequential")
+	t.Setenv("VIVOX_ID_ENCODING", "base64")
+	t.Setenv("VIVOX_CHANNEL_AUTHORIZATION", "party")
+	t.Setenv("VIVOX_TTL_LOGIN", "min=60,max=30")
+	t.Setenv("VIVOX_TTL_KICK", "5-30")
+	t.Setenv("VIVOX_RATE_LIMIT_MUTE_USER", "perMinute=-1")
+	t.Setenv("VIVOX_RATE_LIMIT_LOGIN_CLIENT", "rate=5")
+	t.Setenv("VIVOX_IAM_BAN_ACTIONS", "CHAT_SEND=speak")
+	t.Setenv("VIVOX_SESSION_CHANNEL_TYPES", "party=spatial")
+	t.Setenv("AUDIT_ENABLED", "true")
+	t.Setenv("AUDIT_ACTIONS", "kick,ban")
+
+	_, err := LoadConfig("")
+
+	require.Error(t, err)
+	for _, want := range []string{
+		"BASE_PATH",
+		"VIVOX_DEFAULT_EXPIRY \"soon\" is not an integer",
+		"VIVOX_SERIAL_SOURCE",
+		"VIVOX_ID_ENCODING \"base64\" is not one of none, percent or hash",
+		"VIVOX_CHANNEL_AUTHORIZATION \"party\" is not one of none or session",
+		"VIVOX_ISSUER is required",
+		"VIVOX_DOMAIN is required",
+		"VIVOX_SIGNING_KEY or VIVOX_SIGNING_KEY_FILE is required",
+		"VIVOX_TTL_LOGIN must satisfy 0 < min <= default <= max",
+		"VIVOX_TTL_KICK \"5-30\" is not formatted",
+		"VIVOX_RATE_LIMIT_MUTE_USER must not be negative",
+		"VIVOX_RATE_LIMIT_LOGIN_CLIENT \"rate=5\" is not formatted as perMinute=6,burst=2",
+		"VIVOX_IAM_BAN_ACTIONS CHAT_SEND action \"speak\" is not * or one of",
+		"VIVOX_SESSION_CHANNEL_TYPES party channel type \"spatial\" is not one of echo, nonpositional or positional",
+		"AUDIT_ACTIONS action \"ban\" is not one of",
+		"AUDIT_ACTIONS must include kick and mute",
+	} {
+		assert.ErrorContains(t, err, want)
+	}
+}
+
+func TestConfigValidateTenantsFile(t *testing.T) {
+	config := DefaultConfig()
+	config.Vivox.TenantsFile = "tenants.yaml"
+
+	assert.NoError(t, config.Validate())
+}
+
+func TestReadConfigSkipsValidation(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("VIVOX_ISSUER", "demo")
+
+	config, err := ReadConfig("")
+
+	require.NoError(t, err)
+	require.Equal(t, "demo", config.Vivox.Issuer)
+	require.Error(t, config.Validate())
+
+	t.Setenv("VIVOX_DEFAULT_EXPIRY", "soon")
+	_, err = ReadConfig("")
+	require.ErrorContains(t, err, "VIVOX_DEFAULT_EXPIRY")
+
+	t.Setenv("VIVOX_IAM_BAN_ACTIONS", "join,kick")
+	_, err = ReadConfig("")
+	require.ErrorContains(t, err, "VIVOX_IAM_BAN_ACTIONS \"join,kick\" is not formatted")
+}
@@ -7,6 +7,7 @@ package common
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -21,7 +22,7 @@ type Gateway struct {
 }
 
 func NewGateway(ctx context.Context, grpcServerEndpoint string) (*Gateway, error) {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err := pb.RegisterServiceHandlerFromEndpoint(ctx, mux, grpcServerEndpoint, opts)
 	if err != nil {
@@ -33,6 +34,16 @@ func NewGateway(ctx context.Context, grpcServerEndpoint string) (*Gateway, error
 	}, nil
 }
 
+// outgoingHeaderMatcher forwards the retry-after metadata of rate limited calls as the Retry-After header,
+// other metadata keeps the default Grpc-Metadata- prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, RetryAfterMetadata) {
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Strip the base path, since the base_path configuration in protofile won't actually do the routing
 	// Reference: https://github.com/grpc-ecosystem/grpc-gateway/pull/919/commits/1c34df861cfc0d6cb19ea617921d7d9eaa209977
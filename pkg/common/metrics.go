@@ -0,0 +1,81 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AccelByte/go-jose/jwt"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "vivox_auth"
+
+// Causes of AuthFailures
+const (
+	AuthFailureValidatorUnavailable    = "validator_unavailable"
+	AuthFailureMissingToken            = "missing_token"
+	AuthFailureInvalidToken            = "invalid_token"
+	AuthFailureExpiredToken            = "expired_token"
+	AuthFailureRevokedToken            = "revoked_token"
+	AuthFailureNamespaceMismatch       = "namespace_mismatch"
+	AuthFailureInsufficientPermissions = "insufficient_permissions"
+	AuthFailureTranscriptionDisabled   = "transcription_disabled"
+	AuthFailureVoiceBanned             = "voice_banned"
+	AuthFailureIAMBanned               = "iam_banned"
+	AuthFailureChannelAccessDenied     = "channel_access_denied"
+)
+
+// Token issuance metrics. Labels only take values of bounded sets, never user or channel IDs.
+var (
+	TokensIssued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "tokens_issued_total",
+		Help:      "Vivox tokens issued by action, channel type and namespace.",
+	}, []string{"action", "channel_type", "namespace"})
+
+	ValidationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "validation_failures_total",
+		Help:      "Token requests rejected as invalid, by reason.",
+	}, []string{"reason"})
+
+	AuthFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "auth_failures_total",
+		Help:      "Calls rejected by authentication or authorization, by cause.",
+	}, []string{"cause"})
+
+	SigningDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "signing_duration_seconds",
+		Help:      "Time to build and sign a Vivox token, by action.",
+		Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 12),
+	}, []string{"action"})
+)
+
+// MetricsCollectors returns the token issuance metrics, to register on the metrics registry
+func MetricsCollectors() []prometheus.Collector {
+	return []prometheus.Collector{TokensIssued, ValidationFailures, AuthFailures, SigningDuration}
+}
+
+// validatorFailureCause classifies the errors of the access token validator, which only tells them apart by message
+func validatorFailureCause(err error) string {
+	message := err.Error()
+	switch {
+	case errors.Is(err, jwt.ErrExpired):
+		return AuthFailureExpiredToken
+	case strings.Contains(message, "revoked"):
+		return AuthFailureRevokedToken
+	// permission errors name the resource, which holds the namespace
+	case strings.Contains(message, "insufficient permissions"):
+		return AuthFailureInsufficientPermissions
+	case strings.Contains(message, "namespace"):
+		return AuthFailureNamespaceMismatch
+	default:
+		return AuthFailureInvalidToken
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/go-jose/jwt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValidatorFailureCause(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{err: jwt.ErrExpired, want: AuthFailureExpiredToken},
+		{err: fmt.Errorf("token was revoked"), want: AuthFailureRevokedToken},
+		{err: fmt.Errorf("user was revoked"), want: AuthFailureRevokedToken},
+		{err: fmt.Errorf("extend namespace from token has different a namespace with grpc server"), want: AuthFailureNamespaceMismatch},
+		{err: fmt.Errorf("insufficient permissions in local validation. failed to validate permission [NAMESPACE:{namespace}:VIVOX:TOKEN][1]"), want: AuthFailureInsufficientPermissions},
+		{err: fmt.Errorf("public key not found"), want: AuthFailureInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorFailureCause(tt.err))
+		})
+	}
+}
+
+func TestAuthFailuresMetric(t *testing.T) {
+	original := Validator
+	Validator = &fakeValidator{granted: map[string]bool{}}
+	defer func() { Validator = original }()
+	counter := func(cause string) float64 {
+		return testutil.ToFloat64(AuthFailures.WithLabelValues(cause))
+	}
+	missing, denied := counter(AuthFailureMissingToken), counter(AuthFailureInsufficientPermissions)
+
+	_, err := checkAuthorizationMetadata(metadata.NewIncomingContext(context.Background(), metadata.MD{}), nil, "")
+	require.Error(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+fakeAccessToken(`{"sub":"beef"}`)))
+	_, err = checkAuthorizationMetadata(ctx, &iam.Permission{Resource: playerResource, Action: 1}, "")
+	require.Error(t, err)
+
+	assert.Equal(t, missing+1, counter(AuthFailureMissingToken))
+	assert.Equal(t, denied+1, counter(AuthFailureInsufficientPermissions))
+}
+
+func TestMetricsCollectors(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	for _, collector := range MetricsCollectors() {
+		require.NoError(t, registry.Register(collector))
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+)
+
+// RetryAfterMetadata is the response header metadata telling rate limited callers how many seconds to wait
+const RetryAfterMetadata = "retry-after"
+
+// buckets not used for this long are full again and are dropped
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitCharge is one token taken from the buckets of Action, keyed by scope, e.g. user to the caller's user ID.
+// Scopes without key are not limited.
+type RateLimitCharge struct {
+	Action string
+	Keys   map[string]string
+}
+
+type bucketKey struct {
+	action string
+	scope  string
+	key    string
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// RateLimiter keeps one token bucket per action, scope and key
+type RateLimiter struct {
+	limits map[string]map[string]RateLimit
+	now    func() time.Time
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	swept   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter of limits keyed by action then scope
+func NewRateLimiter(limits map[string]map[string]RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+// Allow takes the tokens of charges when every bucket holds enough of them, otherwise it takes none and returns
+// how long to wait before they are available. A zero wait with false means the charges exceed the burst of a bucket.
+func (l *RateLimiter) Allow(charges ...RateLimitCharge) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	counts := make(map[bucketKey]int)
+	for _, charge := range charges {
+		for scope, key := range charge.Keys {
+			if key == "" || l.limits[charge.Action][scope].PerMinute <= 0 {
+				continue
+			}
+			counts[bucketKey{action: charge.Action, scope: scope, key: key}]++
+		}
+	}
+
+	var wait time.Duration
+	allowed := true
+	for key, count := range counts {
+		limit := l.limits[key.action][key.scope]
+		if float64(count) > burst(limit) {
+			return 0, false
+		}
+		b := l.refill(key, limit, now)
+		if missing := float64(count) - b.tokens; missing > 0 {
+			allowed = false
+			wait = max(wait, time.Duration(missing/perSecond(limit)*float64(time.Second)))
+		}
+	}
+	if !allowed {
+		return wait, false
+	}
+
+	for key, count := range counts {
+		l.buckets[key].tokens -= float64(count)
+	}
+
+	return 0, true
+}
+
+// refill returns the bucket of key with the tokens added since it was last used
+func (l *RateLimiter) refill(key bucketKey, limit RateLimit, now time.Time) *bucket {
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: burst(limit), updated: now}
+		l.buckets[key] = b
+
+		return b
+	}
+
+	b.tokens = math.Min(burst(limit), b.tokens+now.Sub(b.updated).Seconds()*perSecond(limit))
+	b.updated = now
+
+	return b
+}
+
+// sweep drops the buckets that are full again, they are recreated full when used
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < rateLimitSweepInterval {
+		return
+	}
+	l.swept = now
+
+	for key, b := range l.buckets {
+		limit := l.limits[key.action][key.scope]
+		if b.tokens+now.Sub(b.updated).Seconds()*perSecond(limit) >= burst(limit) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func perSecond(limit RateLimit) float64 {
+	return float64(limit.PerMinute) / 60
+}
+
+func burst(limit RateLimit) float64 {
+	return float64(max(limit.Burst, 1))
+}
+
+func NewUnaryRateLimitServerIntercept(
+	limiter *RateLimiter,
+) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) { // nolint
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := CheckRateLimit(ctx, limiter, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func NewStreamRateLimitServerIntercept(
+	limiter *RateLimiter,
+) func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &rateLimitServerStream{WrappedServerStream: middleware.WrapServerStream(ss), limiter: limiter})
+	}
+}
+
+// rateLimitServerStream charges the tokens of each received request
+type rateLimitServerStream struct {
+	*middleware.WrappedServerStream
+	limiter *RateLimiter
+}
+
+func (s *rateLimitServerStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return CheckRateLimit(s.Context(), s.limiter, m)
+}
+
+// CheckRateLimit returns a ResourceExhausted status when the tokens requested by req are over the limits of the caller
+func CheckRateLimit(ctx context.Context, limiter *RateLimiter, req interface{}) error {
+	charges := rateLimitCharges(ctx, req)
+	if len(charges) == 0 {
+		return nil
+	}
+
+	wait, allowed := limiter.Allow(charges...)
+	if allowed {
+		return nil
+	}
+	if wait == 0 {
+		return status.Errorf(codes.ResourceExhausted, "%d tokens requested at once exceed the rate limit", len(charges))
+	}
+
+	seconds := int64(math.Ceil(wait.Seconds()))
+	// SetHeader only fails outside of a gRPC call, the RetryInfo detail still tells the delay
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadata, strconv.FormatInt(seconds, 10)))
+	st := status.Newf(codes.ResourceExhausted, "token rate limit exceeded, retry after %d seconds", seconds)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(seconds) * time.Second)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// rateLimitCharges returns one charge per token requested by req, keyed by the caller. The namespace scope is the
+// caller's namespace rather than the requested one, which is not authorized yet and would open a bucket per value.
+func rateLimitCharges(ctx context.Context, req interface{}) []RateLimitCharge {
+	var userID, clientID, namespace string
+	if authInfo, found := AuthInfoFromContext(ctx); found {
+		userID, clientID, namespace = authInfo.UserID(), authInfo.Claims.ClientID, authInfo.Claims.Namespace
+	}
+	if namespace == "" {
+		namespace = getNamespace()
+	}
+	charge := func(action pb.GenerateVivoxTokenRequestType) RateLimitCharge {
+		return RateLimitCharge{
+			Action: action.String(),
+			Keys: map[string]string{
+				RateLimitScopeUser:      userID,
+				RateLimitScopeClient:    clientID,
+				RateLimitScopeNamespace: namespace,
+			},
+		}
+	}
+
+	switch r := req.(type) {
+	case *pb.GenerateVivoxTokenRequest:
+		// also the request of a refresh stream, whose later tokens are charged by the service
+		return []RateLimitCharge{charge(r.GetType())}
+	case *pb.GenerateVivoxTokensRequest:
+		charges := make([]RateLimitCharge, 0, len(r.GetTokens()))
+		for _, tokenReq := range r.GetTokens() {
+			charges = append(charges, charge(tokenReq.GetType()))
+		}
+
+		return charges
+	case *pb.JoinSessionVoiceRequest:
+		// the login and join tokens of the session channel, and the join token of the team channel when requested
+		charges := []RateLimitCharge{charge(pb.GenerateVivoxTokenRequestType_login), charge(pb.GenerateVivoxTokenRequestType_join)}
+		if r.GetTeamChannel() {
+			charges = append(charges, charge(pb.GenerateVivoxTokenRequestType_join))
+		}
+
+		return charges
+	default:
+		return nil
+	}
+}
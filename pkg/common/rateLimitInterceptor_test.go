@@ -0,0 +1,196 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package common
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	clock := time.Unix(1700000000, 0)
+	limiter := NewRateLimiter(map[string]map[string]RateLimit{
+		"kick":  {RateLimitScopeUser: {PerMinute: 6, Burst: 2}, RateLimitScopeNamespace: {PerMinute: 60, Burst: 3}},
+		"login": {RateLimitScopeUser: {PerMinute: 60, Burst: 10}},
+	})
+	limiter.now = func() time.Time { return clock }
+	kick := func(user string) RateLimitCharge {
+		return RateLimitCharge{Action: "kick", Keys: map[string]string{RateLimitScopeUser: user, RateLimitScopeNamespace: "game"}}
+	}
+
+	// the burst is available at once, then a kick token every 10 seconds
+	for i := 0; i < 2; i++ {
+		_, allowed := limiter.Allow(kick("alice"))
+		require.True(t, allowed)
+	}
+	wait, allowed := limiter.Allow(kick("alice"))
+	assert.False(t, allowed)
+	assert.Equal(t, 10*time.Second, wait)
+
+	// other actions and users have their own buckets
+	_, allowed = limiter.Allow(RateLimitCharge{Action: "login", Keys: map[string]string{RateLimitScopeUser: "alice"}})
+	assert.True(t, allowed)
+	_, allowed = limiter.Allow(RateLimitCharge{Action: "mute", Keys: map[string]string{RateLimitScopeUser: "alice"}})
+	assert.True(t, allowed, "actions without limits are not limited")
+	_, allowed = limiter.Allow(kick("bob"))
+	assert.True(t, allowed)
+
+	// the namespace bucket is empty, so bob is rejected without taking their user token
+	wait, allowed = limiter.Allow(kick("bob"))
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, wait)
+	clock = clock.Add(time.Second)
+	_, allowed = limiter.Allow(kick("bob"))
+	assert.True(t, allowed)
+
+	clock = clock.Add(9 * time.Second)
+	_, allowed = limiter.Allow(kick("alice"))
+	assert.True(t, allowed)
+
+	// more tokens than the burst at once are never allowed
+	wait, allowed = limiter.Allow(kick("carol"), kick("carol"), kick("carol"))
+	assert.False(t, allowed)
+	assert.Zero(t, wait)
+
+	// full buckets are dropped
+	clock = clock.Add(time.Hour)
+	limiter.Allow()
+	assert.Empty(t, limiter.buckets)
+}
+
+func TestRateLimitCharges(t *testing.T) {
+	claims := iam.JWTClaims{ClientID: "client", Namespace: "game"}
+	claims.Subject = "alice"
+	ctx := ContextWithAuthInfo(context.Background(), &AuthInfo{Claims: claims})
+
+	charges := rateLimitCharges(ctx, &pb.GenerateVivoxTokensRequest{
+		Namespace: "batch",
+		Tokens: []*pb.GenerateVivoxTokenRequest{
+			{Type: pb.GenerateVivoxTokenRequestType_login},
+			{Type: pb.GenerateVivoxTokenRequestType_kick, Namespace: "other"},
+		},
+	})
+
+	// the requested namespaces are not authorized yet, the caller's namespace is charged
+	assert.Equal(t, []RateLimitCharge{
+		{Action: "login", Keys: map[string]string{RateLimitScopeUser: "alice", RateLimitScopeClient: "client", RateLimitScopeNamespace: "game"}},
+		{Action: "kick", Keys: map[string]string{RateLimitScopeUser: "alice", RateLimitScopeClient: "client", RateLimitScopeNamespace: "game"}},
+	}, charges)
+	assert.Equal(t, "game", rateLimitCharges(ctx, &pb.GenerateVivoxTokenRequest{Namespace: "other"})[0].Keys[RateLimitScopeNamespace])
+	assert.Equal(t, getNamespace(), rateLimitCharges(context.Background(), &pb.GenerateVivoxTokenRequest{Namespace: "other"})[0].Keys[RateLimitScopeNamespace])
+	assert.Nil(t, rateLimitCharges(ctx, &pb.VerifyVivoxTokenRequest{}))
+
+	var actions []string
+	for _, charge := range rateLimitCharges(ctx, &pb.JoinSessionVoiceRequest{SessionId: "match1", TeamChannel: true}) {
+		actions = append(actions, charge.Action)
+		assert.Equal(t, "game", charge.Keys[RateLimitScopeNamespace])
+	}
+	assert.Equal(t, []string{"login", "join", "join"}, actions)
+	assert.Len(t, rateLimitCharges(ctx, &pb.JoinSessionVoiceRequest{SessionId: "party1"}), 2)
+}
+
+type rateLimitTestServer struct {
+	pb.UnimplementedServiceServer
+}
+
+func (s *rateLimitTestServer) GenerateVivoxToken(ctx context.Context, req *pb.GenerateVivoxTokenRequest) (*pb.GenerateVivoxTokenResponse, error) {
+	return &pb.GenerateVivoxTokenResponse{AccessToken: "token"}, nil
+}
+
+func (s *rateLimitTestServer) RefreshVivoxToken(req *pb.GenerateVivoxTokenRequest, stream pb.Service_RefreshVivoxTokenServer) error {
+	return stream.Send(&pb.GenerateVivoxTokenResponse{AccessToken: "token"})
+}
+
+func TestStreamRateLimitServerIntercept(t *testing.T) {
+	limiter := NewRateLimiter(map[string]map[string]RateLimit{
+		"join": {RateLimitScopeNamespace: {PerMinute: 2, Burst: 1}},
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.ChainStreamInterceptor(NewStreamRateLimitServerIntercept(limiter)))
+	pb.RegisterServiceServer(server, &rateLimitTestServer{})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := pb.NewServiceClient(conn)
+	join := &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_join}
+
+	refresh := func() error {
+		stream, err := client.RefreshVivoxToken(context.Background(), join)
+		require.NoError(t, err)
+		_, err = stream.Recv()
+
+		return err
+	}
+
+	require.NoError(t, refresh())
+	assert.Equal(t, codes.ResourceExhausted, status.Code(refresh()), "each stream is charged its first token")
+}
+
+func TestRateLimitServerIntercept(t *testing.T) {
+	limiter := NewRateLimiter(map[string]map[string]RateLimit{
+		"kick": {RateLimitScopeNamespace: {PerMinute: 2, Burst: 1}},
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(NewUnaryRateLimitServerIntercept(limiter)))
+	pb.RegisterServiceServer(server, &rateLimitTestServer{})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := pb.NewServiceClient(conn)
+	kick := &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_kick, Namespace: "game"}
+
+	_, err = client.GenerateVivoxToken(context.Background(), kick)
+	require.NoError(t, err)
+
+	var header metadata.MD
+	_, err = client.GenerateVivoxToken(context.Background(), kick, grpc.Header(&header))
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, []string{"30"}, header.Get(RetryAfterMetadata))
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, 30*time.Second, st.Details()[0].(*errdetails.RetryInfo).GetRetryDelay().AsDuration())
+
+	_, err = client.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login})
+	assert.NoError(t, err, "login is not limited")
+
+	t.Run("gateway", func(t *testing.T) {
+		gateway, err := NewGateway(context.Background(), listener.Addr().String())
+		require.NoError(t, err)
+		httpServer := httptest.NewServer(gateway)
+		t.Cleanup(httpServer.Close)
+
+		resp, err := http.Post(httpServer.URL+BasePath+"/v1/namespaces/game/token", "application/json", strings.NewReader(`{"type":"kick"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+	})
+}
@@ -5,12 +5,9 @@
 package common
 
 import (
-	"log/slog"
-	"math/rand"
 	"os"
 	"strconv"
 	"strings"
-	"time"
 )
 
 func GetEnv(key, fallback string) string {
@@ -31,16 +28,6 @@ func GetEnvInt(key string, fallback int) int {
 	return val
 }
 
-func getBasePath() string {
-	basePath := GetEnv("BASE_PATH", "/vivoxauth")
-	if !strings.HasPrefix(basePath, "/") {
-		slog.Default().Error("BASE_PATH envar is invalid, no leading '/' found. Valid example: /basePath")
-		os.Exit(1)
-	}
-
-	return basePath
-}
-
 func SetEnv(key, value string) error {
 	err := os.Setenv(key, value)
 	if err == nil {
@@ -49,23 +36,3 @@ func SetEnv(key, value string) error {
 
 	return err
 }
-
-func RandomNumber(n int) int64 {
-	if n <= 0 {
-		return 0
-	}
-
-	lowerBound := int64(1)
-	upperBound := int64(9)
-
-	for i := 1; i < n; i++ {
-		lowerBound *= 10
-		upperBound = upperBound*10 + 9
-	}
-
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	randomNum := lowerBound + r.Int63n(upperBound-lowerBound+1)
-
-	return randomNum
-}
@@ -94,6 +94,22 @@ var file_permission_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "varint,50002,opt,name=action,enum=permission.Action",
 		Filename:      "permission.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50003,
+		Name:          "permission.value_resource",
+		Tag:           "bytes,50003,opt,name=value_resource",
+		Filename:      "permission.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*Action)(nil),
+		Field:         50004,
+		Name:          "permission.value_action",
+		Tag:           "varint,50004,opt,name=value_action,enum=permission.Action",
+		Filename:      "permission.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MethodOptions.
@@ -104,6 +120,14 @@ var (
 	E_Action = &file_permission_proto_extTypes[1]
 )
 
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional string value_resource = 50003;
+	E_ValueResource = &file_permission_proto_extTypes[2]
+	// optional permission.Action value_action = 50004;
+	E_ValueAction = &file_permission_proto_extTypes[3]
+)
+
 var File_permission_proto protoreflect.FileDescriptor
 
 const file_permission_proto_rawDesc = "" +
@@ -120,7 +144,9 @@ const file_permission_proto_rawDesc = "" +
 	"\n" +
 	"\x06DELETE\x10\b:<\n" +
 	"\bresource\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\tR\bresource:L\n" +
-	"\x06action\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\x0e2\x12.permission.ActionR\x06actionBt\n" +
+	"\x06action\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\x0e2\x12.permission.ActionR\x06action:J\n" +
+	"\x0evalue_resource\x12!.google.protobuf.EnumValueOptions\x18ӆ\x03 \x01(\tR\rvalueResource:Z\n" +
+	"\fvalue_action\x12!.google.protobuf.EnumValueOptions\x18Ԇ\x03 \x01(\x0e2\x12.permission.ActionR\vvalueActionBt\n" +
 	"%net.accelbyte.extend.serviceextensionP\x01Z%accelbyte.net/extend/serviceextension\xaa\x02!AccelByte.Extend.ServiceExtensionb\x06proto3"
 
 var (
@@ -137,17 +163,21 @@ func file_permission_proto_rawDescGZIP() []byte {
 
 var file_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_permission_proto_goTypes = []any{
-	(Action)(0),                        // 0: permission.Action
-	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+	(Action)(0),                           // 0: permission.Action
+	(*descriptorpb.MethodOptions)(nil),    // 1: google.protobuf.MethodOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 2: google.protobuf.EnumValueOptions
 }
 var file_permission_proto_depIdxs = []int32{
 	1, // 0: permission.resource:extendee -> google.protobuf.MethodOptions
 	1, // 1: permission.action:extendee -> google.protobuf.MethodOptions
-	0, // 2: permission.action:type_name -> permission.Action
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	2, // [2:3] is the sub-list for extension type_name
-	0, // [0:2] is the sub-list for extension extendee
+	2, // 2: permission.value_resource:extendee -> google.protobuf.EnumValueOptions
+	2, // 3: permission.value_action:extendee -> google.protobuf.EnumValueOptions
+	0, // 4: permission.action:type_name -> permission.Action
+	0, // 5: permission.value_action:type_name -> permission.Action
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	4, // [4:6] is the sub-list for extension type_name
+	0, // [0:4] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
 }
 
@@ -163,7 +193,7 @@ func file_permission_proto_init() {
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_proto_rawDesc), len(file_permission_proto_rawDesc)),
 			NumEnums:      1,
 			NumMessages:   0,
-			NumExtensions: 2,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_permission_proto_goTypes,
@@ -27,6 +27,211 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type JoinSessionVoiceSessionType int32
+
+const (
+	JoinSessionVoiceSessionType_joinsessionvoicesessiontype_unknown JoinSessionVoiceSessionType = 0
+	JoinSessionVoiceSessionType_game_session                        JoinSessionVoiceSessionType = 1
+	JoinSessionVoiceSessionType_party_session                       JoinSessionVoiceSessionType = 2
+)
+
+// Enum value maps for JoinSessionVoiceSessionType.
+var (
+	JoinSessionVoiceSessionType_name = map[int32]string{
+		0: "joinsessionvoicesessiontype_unknown",
+		1: "game_session",
+		2: "party_session",
+	}
+	JoinSessionVoiceSessionType_value = map[string]int32{
+		"joinsessionvoicesessiontype_unknown": 0,
+		"game_session":                        1,
+		"party_session":                       2,
+	}
+)
+
+func (x JoinSessionVoiceSessionType) Enum() *JoinSessionVoiceSessionType {
+	p := new(JoinSessionVoiceSessionType)
+	*p = x
+	return p
+}
+
+func (x JoinSessionVoiceSessionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinSessionVoiceSessionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[0].Descriptor()
+}
+
+func (JoinSessionVoiceSessionType) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[0]
+}
+
+func (x JoinSessionVoiceSessionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinSessionVoiceSessionType.Descriptor instead.
+func (JoinSessionVoiceSessionType) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{0}
+}
+
+type VivoxUriKind int32
+
+const (
+	VivoxUriKind_vivoxurikind_unknown VivoxUriKind = 0
+	VivoxUriKind_vivoxurikind_user    VivoxUriKind = 1
+	VivoxUriKind_vivoxurikind_channel VivoxUriKind = 2
+	VivoxUriKind_vivoxurikind_server  VivoxUriKind = 3
+)
+
+// Enum value maps for VivoxUriKind.
+var (
+	VivoxUriKind_name = map[int32]string{
+		0: "vivoxurikind_unknown",
+		1: "vivoxurikind_user",
+		2: "vivoxurikind_channel",
+		3: "vivoxurikind_server",
+	}
+	VivoxUriKind_value = map[string]int32{
+		"vivoxurikind_unknown": 0,
+		"vivoxurikind_user":    1,
+		"vivoxurikind_channel": 2,
+		"vivoxurikind_server":  3,
+	}
+)
+
+func (x VivoxUriKind) Enum() *VivoxUriKind {
+	p := new(VivoxUriKind)
+	*p = x
+	return p
+}
+
+func (x VivoxUriKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VivoxUriKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[1].Descriptor()
+}
+
+func (VivoxUriKind) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[1]
+}
+
+func (x VivoxUriKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VivoxUriKind.Descriptor instead.
+func (VivoxUriKind) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{1}
+}
+
+type VivoxBanScope int32
+
+const (
+	VivoxBanScope_vivoxbanscope_unknown VivoxBanScope = 0
+	VivoxBanScope_global_ban            VivoxBanScope = 1
+	VivoxBanScope_channel_ban           VivoxBanScope = 2
+)
+
+// Enum value maps for VivoxBanScope.
+var (
+	VivoxBanScope_name = map[int32]string{
+		0: "vivoxbanscope_unknown",
+		1: "global_ban",
+		2: "channel_ban",
+	}
+	VivoxBanScope_value = map[string]int32{
+		"vivoxbanscope_unknown": 0,
+		"global_ban":            1,
+		"channel_ban":           2,
+	}
+)
+
+func (x VivoxBanScope) Enum() *VivoxBanScope {
+	p := new(VivoxBanScope)
+	*p = x
+	return p
+}
+
+func (x VivoxBanScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VivoxBanScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[2].Descriptor()
+}
+
+func (VivoxBanScope) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[2]
+}
+
+func (x VivoxBanScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VivoxBanScope.Descriptor instead.
+func (VivoxBanScope) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{2}
+}
+
+type VerifyVivoxTokenFailureReason int32
+
+const (
+	VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_none              VerifyVivoxTokenFailureReason = 0
+	VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_malformed         VerifyVivoxTokenFailureReason = 1
+	VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_invalid_signature VerifyVivoxTokenFailureReason = 2
+	VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_expired           VerifyVivoxTokenFailureReason = 3
+	VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_issuer_mismatch   VerifyVivoxTokenFailureReason = 4
+)
+
+// Enum value maps for VerifyVivoxTokenFailureReason.
+var (
+	VerifyVivoxTokenFailureReason_name = map[int32]string{
+		0: "verifyvivoxtokenfailurereason_none",
+		1: "verifyvivoxtokenfailurereason_malformed",
+		2: "verifyvivoxtokenfailurereason_invalid_signature",
+		3: "verifyvivoxtokenfailurereason_expired",
+		4: "verifyvivoxtokenfailurereason_issuer_mismatch",
+	}
+	VerifyVivoxTokenFailureReason_value = map[string]int32{
+		"verifyvivoxtokenfailurereason_none":              0,
+		"verifyvivoxtokenfailurereason_malformed":         1,
+		"verifyvivoxtokenfailurereason_invalid_signature": 2,
+		"verifyvivoxtokenfailurereason_expired":           3,
+		"verifyvivoxtokenfailurereason_issuer_mismatch":   4,
+	}
+)
+
+func (x VerifyVivoxTokenFailureReason) Enum() *VerifyVivoxTokenFailureReason {
+	p := new(VerifyVivoxTokenFailureReason)
+	*p = x
+	return p
+}
+
+func (x VerifyVivoxTokenFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerifyVivoxTokenFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[3].Descriptor()
+}
+
+func (VerifyVivoxTokenFailureReason) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[3]
+}
+
+func (x VerifyVivoxTokenFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerifyVivoxTokenFailureReason.Descriptor instead.
+func (VerifyVivoxTokenFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{3}
+}
+
 type GenerateVivoxTokenRequestType int32
 
 const (
@@ -35,6 +240,8 @@ const (
 	GenerateVivoxTokenRequestType_join                                   GenerateVivoxTokenRequestType = 2
 	GenerateVivoxTokenRequestType_join_muted                             GenerateVivoxTokenRequestType = 3
 	GenerateVivoxTokenRequestType_kick                                   GenerateVivoxTokenRequestType = 4
+	GenerateVivoxTokenRequestType_mute                                   GenerateVivoxTokenRequestType = 5
+	GenerateVivoxTokenRequestType_transcription                          GenerateVivoxTokenRequestType = 6
 )
 
 // Enum value maps for GenerateVivoxTokenRequestType.
@@ -45,6 +252,8 @@ var (
 		2: "join",
 		3: "join_muted",
 		4: "kick",
+		5: "mute",
+		6: "transcription",
 	}
 	GenerateVivoxTokenRequestType_value = map[string]int32{
 		"generatevivoxtokenrequest_type_unknown": 0,
@@ -52,6 +261,8 @@ var (
 		"join":                                   2,
 		"join_muted":                             3,
 		"kick":                                   4,
+		"mute":                                   5,
+		"transcription":                          6,
 	}
 )
 
@@ -66,11 +277,11 @@ func (x GenerateVivoxTokenRequestType) String() string {
 }
 
 func (GenerateVivoxTokenRequestType) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[0].Descriptor()
+	return file_service_proto_enumTypes[4].Descriptor()
 }
 
 func (GenerateVivoxTokenRequestType) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[0]
+	return &file_service_proto_enumTypes[4]
 }
 
 func (x GenerateVivoxTokenRequestType) Number() protoreflect.EnumNumber {
@@ -79,7 +290,7 @@ func (x GenerateVivoxTokenRequestType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GenerateVivoxTokenRequestType.Descriptor instead.
 func (GenerateVivoxTokenRequestType) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{0}
+	return file_service_proto_rawDescGZIP(), []int{4}
 }
 
 type GenerateVivoxTokenRequestChannelType int32
@@ -118,11 +329,11 @@ func (x GenerateVivoxTokenRequestChannelType) String() string {
 }
 
 func (GenerateVivoxTokenRequestChannelType) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[1].Descriptor()
+	return file_service_proto_enumTypes[5].Descriptor()
 }
 
 func (GenerateVivoxTokenRequestChannelType) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[1]
+	return &file_service_proto_enumTypes[5]
 }
 
 func (x GenerateVivoxTokenRequestChannelType) Number() protoreflect.EnumNumber {
@@ -131,18 +342,73 @@ func (x GenerateVivoxTokenRequestChannelType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GenerateVivoxTokenRequestChannelType.Descriptor instead.
 func (GenerateVivoxTokenRequestChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{1}
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+type GenerateVivoxTokenRequestFadeModel int32
+
+const (
+	GenerateVivoxTokenRequestFadeModel_generatevivoxtokenrequest_fademodel_unknown GenerateVivoxTokenRequestFadeModel = 0
+	GenerateVivoxTokenRequestFadeModel_inverse_by_distance                         GenerateVivoxTokenRequestFadeModel = 1
+	GenerateVivoxTokenRequestFadeModel_linear_by_distance                          GenerateVivoxTokenRequestFadeModel = 2
+	GenerateVivoxTokenRequestFadeModel_exponential_by_distance                     GenerateVivoxTokenRequestFadeModel = 3
+)
+
+// Enum value maps for GenerateVivoxTokenRequestFadeModel.
+var (
+	GenerateVivoxTokenRequestFadeModel_name = map[int32]string{
+		0: "generatevivoxtokenrequest_fademodel_unknown",
+		1: "inverse_by_distance",
+		2: "linear_by_distance",
+		3: "exponential_by_distance",
+	}
+	GenerateVivoxTokenRequestFadeModel_value = map[string]int32{
+		"generatevivoxtokenrequest_fademodel_unknown": 0,
+		"inverse_by_distance":                         1,
+		"linear_by_distance":                          2,
+		"exponential_by_distance":                     3,
+	}
+)
+
+func (x GenerateVivoxTokenRequestFadeModel) Enum() *GenerateVivoxTokenRequestFadeModel {
+	p := new(GenerateVivoxTokenRequestFadeModel)
+	*p = x
+	return p
+}
+
+func (x GenerateVivoxTokenRequestFadeModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerateVivoxTokenRequestFadeModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[6].Descriptor()
+}
+
+func (GenerateVivoxTokenRequestFadeModel) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[6]
+}
+
+func (x GenerateVivoxTokenRequestFadeModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerateVivoxTokenRequestFadeModel.Descriptor instead.
+func (GenerateVivoxTokenRequestFadeModel) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{6}
 }
 
 type GenerateVivoxTokenRequest struct {
-	state          protoimpl.MessageState               `protogen:"open.v1"`
-	Type           GenerateVivoxTokenRequestType        `protobuf:"varint,1,opt,name=type,proto3,enum=service.GenerateVivoxTokenRequestType" json:"type,omitempty"`
-	Username       string                               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	ChannelId      string                               `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	ChannelType    GenerateVivoxTokenRequestChannelType `protobuf:"varint,4,opt,name=channelType,proto3,enum=service.GenerateVivoxTokenRequestChannelType" json:"channelType,omitempty"`
-	TargetUsername string                               `protobuf:"bytes,5,opt,name=targetUsername,proto3" json:"targetUsername,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState                      `protogen:"open.v1"`
+	Type              GenerateVivoxTokenRequestType               `protobuf:"varint,1,opt,name=type,proto3,enum=service.GenerateVivoxTokenRequestType" json:"type,omitempty"`
+	Username          string                                      `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ChannelId         string                                      `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ChannelType       GenerateVivoxTokenRequestChannelType        `protobuf:"varint,4,opt,name=channelType,proto3,enum=service.GenerateVivoxTokenRequestChannelType" json:"channelType,omitempty"`
+	TargetUsername    string                                      `protobuf:"bytes,5,opt,name=targetUsername,proto3" json:"targetUsername,omitempty"`
+	ChannelProperties *GenerateVivoxTokenRequestChannelProperties `protobuf:"bytes,6,opt,name=channelProperties,proto3" json:"channelProperties,omitempty"`
+	Namespace         string                                      `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExpiresInSeconds  int32                                       `protobuf:"varint,8,opt,name=expiresInSeconds,proto3" json:"expiresInSeconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GenerateVivoxTokenRequest) Reset() {
@@ -210,28 +476,49 @@ func (x *GenerateVivoxTokenRequest) GetTargetUsername() string {
 	return ""
 }
 
-type GenerateVivoxTokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+func (x *GenerateVivoxTokenRequest) GetChannelProperties() *GenerateVivoxTokenRequestChannelProperties {
+	if x != nil {
+		return x.ChannelProperties
+	}
+	return nil
+}
+
+func (x *GenerateVivoxTokenRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenRequest) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type GenerateVivoxTokensRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Namespace     string                       `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Tokens        []*GenerateVivoxTokenRequest `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateVivoxTokenResponse) Reset() {
-	*x = GenerateVivoxTokenResponse{}
+func (x *GenerateVivoxTokensRequest) Reset() {
+	*x = GenerateVivoxTokensRequest{}
 	mi := &file_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateVivoxTokenResponse) String() string {
+func (x *GenerateVivoxTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateVivoxTokenResponse) ProtoMessage() {}
+func (*GenerateVivoxTokensRequest) ProtoMessage() {}
 
-func (x *GenerateVivoxTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateVivoxTokensRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,60 +530,1715 @@ func (x *GenerateVivoxTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateVivoxTokenResponse.ProtoReflect.Descriptor instead.
-func (*GenerateVivoxTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateVivoxTokensRequest.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokensRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GenerateVivoxTokenResponse) GetAccessToken() string {
+func (x *GenerateVivoxTokensRequest) GetNamespace() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GenerateVivoxTokenResponse) GetUri() string {
+func (x *GenerateVivoxTokensRequest) GetTokens() []*GenerateVivoxTokenRequest {
 	if x != nil {
-		return x.Uri
+		return x.Tokens
 	}
-	return ""
+	return nil
 }
 
-var File_service_proto protoreflect.FileDescriptor
+type GenerateVivoxTokensResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Results       []*GenerateVivoxTokensResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_service_proto_rawDesc = "" +
-	"\n" +
-	"\rservice.proto\x12\aservice\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x10permission.proto\"\x9b\x03\n" +
-	"\x19GenerateVivoxTokenRequest\x12I\n" +
-	"\x04type\x18\x01 \x01(\x0e2&.service.GenerateVivoxTokenRequestTypeB\r\x92A\n" +
-	"2\bRequiredR\x04type\x12)\n" +
-	"\busername\x18\x02 \x01(\tB\r\x92A\n" +
-	"2\bRequiredR\busername\x12:\n" +
-	"\tchannelId\x18\x03 \x01(\tB\x1c\x92A\x192\x17Required if type = joinR\tchannelId\x12m\n" +
-	"\vchannelType\x18\x04 \x01(\x0e2-.service.GenerateVivoxTokenRequestChannelTypeB\x1c\x92A\x192\x17Required if type = joinR\vchannelType\x12D\n" +
-	"\x0etargetUsername\x18\x05 \x01(\tB\x1c\x92A\x192\x17Required if type = kickR\x0etargetUsername:\x17\x92A\x14\n" +
-	"\x12\xd2\x01\x04type\xd2\x01\busername\"P\n" +
-	"\x1aGenerateVivoxTokenResponse\x12 \n" +
-	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12\x10\n" +
-	"\x03uri\x18\x02 \x01(\tR\x03uri*z\n" +
-	"\x1dGenerateVivoxTokenRequestType\x12*\n" +
-	"&generatevivoxtokenrequest_type_unknown\x10\x00\x12\t\n" +
-	"\x05login\x10\x01\x12\b\n" +
-	"\x04join\x10\x02\x12\x0e\n" +
-	"\n" +
-	"join_muted\x10\x03\x12\b\n" +
-	"\x04kick\x10\x04*\x86\x01\n" +
-	"$GenerateVivoxTokenRequestChannelType\x121\n" +
-	"-generatevivoxtokenrequest_channeltype_unknown\x10\x00\x12\b\n" +
-	"\x04echo\x10\x01\x12\x0e\n" +
-	"\n" +
-	"positional\x10\x02\x12\x11\n" +
-	"\rnonpositional\x10\x032\xa6\x01\n" +
-	"\aService\x12\x9a\x01\n" +
-	"\x12GenerateVivoxToken\x12\".service.GenerateVivoxTokenRequest\x1a#.service.GenerateVivoxTokenResponse\";\x92A$\x12\x14Generate Vivox tokenb\f\n" +
+func (x *GenerateVivoxTokensResponse) Reset() {
+	*x = GenerateVivoxTokensResponse{}
+	mi := &file_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVivoxTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVivoxTokensResponse) ProtoMessage() {}
+
+func (x *GenerateVivoxTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVivoxTokensResponse.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokensResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateVivoxTokensResponse) GetResults() []*GenerateVivoxTokensResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GenerateVivoxTokensResult struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Token         *GenerateVivoxTokenResponse `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Error         *GenerateVivoxTokensError   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateVivoxTokensResult) Reset() {
+	*x = GenerateVivoxTokensResult{}
+	mi := &file_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVivoxTokensResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVivoxTokensResult) ProtoMessage() {}
+
+func (x *GenerateVivoxTokensResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVivoxTokensResult.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokensResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateVivoxTokensResult) GetToken() *GenerateVivoxTokenResponse {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GenerateVivoxTokensResult) GetError() *GenerateVivoxTokensError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GenerateVivoxTokensError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateVivoxTokensError) Reset() {
+	*x = GenerateVivoxTokensError{}
+	mi := &file_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVivoxTokensError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVivoxTokensError) ProtoMessage() {}
+
+func (x *GenerateVivoxTokensError) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVivoxTokensError.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokensError) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerateVivoxTokensError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokensError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GenerateVivoxTokenRequestChannelProperties struct {
+	state                  protoimpl.MessageState             `protogen:"open.v1"`
+	AudibleDistance        int32                              `protobuf:"varint,1,opt,name=audibleDistance,proto3" json:"audibleDistance,omitempty"`
+	ConversationalDistance int32                              `protobuf:"varint,2,opt,name=conversationalDistance,proto3" json:"conversationalDistance,omitempty"`
+	FadeIntensity          float64                            `protobuf:"fixed64,3,opt,name=fadeIntensity,proto3" json:"fadeIntensity,omitempty"`
+	FadeModel              GenerateVivoxTokenRequestFadeModel `protobuf:"varint,4,opt,name=fadeModel,proto3,enum=service.GenerateVivoxTokenRequestFadeModel" json:"fadeModel,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) Reset() {
+	*x = GenerateVivoxTokenRequestChannelProperties{}
+	mi := &file_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVivoxTokenRequestChannelProperties) ProtoMessage() {}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVivoxTokenRequestChannelProperties.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokenRequestChannelProperties) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) GetAudibleDistance() int32 {
+	if x != nil {
+		return x.AudibleDistance
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) GetConversationalDistance() int32 {
+	if x != nil {
+		return x.ConversationalDistance
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) GetFadeIntensity() float64 {
+	if x != nil {
+		return x.FadeIntensity
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokenRequestChannelProperties) GetFadeModel() GenerateVivoxTokenRequestFadeModel {
+	if x != nil {
+		return x.FadeModel
+	}
+	return GenerateVivoxTokenRequestFadeModel_generatevivoxtokenrequest_fademodel_unknown
+}
+
+type GenerateVivoxTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	FromUri       string                 `protobuf:"bytes,4,opt,name=fromUri,proto3" json:"fromUri,omitempty"`
+	ToUri         string                 `protobuf:"bytes,5,opt,name=toUri,proto3" json:"toUri,omitempty"`
+	SubUri        string                 `protobuf:"bytes,6,opt,name=subUri,proto3" json:"subUri,omitempty"`
+	Serial        int64                  `protobuf:"varint,7,opt,name=serial,proto3" json:"serial,omitempty"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateVivoxTokenResponse) Reset() {
+	*x = GenerateVivoxTokenResponse{}
+	mi := &file_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVivoxTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVivoxTokenResponse) ProtoMessage() {}
+
+func (x *GenerateVivoxTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVivoxTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateVivoxTokenResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GenerateVivoxTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokenResponse) GetFromUri() string {
+	if x != nil {
+		return x.FromUri
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenResponse) GetToUri() string {
+	if x != nil {
+		return x.ToUri
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenResponse) GetSubUri() string {
+	if x != nil {
+		return x.SubUri
+	}
+	return ""
+}
+
+func (x *GenerateVivoxTokenResponse) GetSerial() int64 {
+	if x != nil {
+		return x.Serial
+	}
+	return 0
+}
+
+func (x *GenerateVivoxTokenResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type JoinSessionVoiceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SessionId        string                 `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	Username         string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	TeamChannel      bool                   `protobuf:"varint,4,opt,name=teamChannel,proto3" json:"teamChannel,omitempty"`
+	ExpiresInSeconds int32                  `protobuf:"varint,5,opt,name=expiresInSeconds,proto3" json:"expiresInSeconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JoinSessionVoiceRequest) Reset() {
+	*x = JoinSessionVoiceRequest{}
+	mi := &file_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinSessionVoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinSessionVoiceRequest) ProtoMessage() {}
+
+func (x *JoinSessionVoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinSessionVoiceRequest.ProtoReflect.Descriptor instead.
+func (*JoinSessionVoiceRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JoinSessionVoiceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceRequest) GetTeamChannel() bool {
+	if x != nil {
+		return x.TeamChannel
+	}
+	return false
+}
+
+func (x *JoinSessionVoiceRequest) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type JoinSessionVoiceResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	SessionId     string                               `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	SessionType   JoinSessionVoiceSessionType          `protobuf:"varint,2,opt,name=sessionType,proto3,enum=service.JoinSessionVoiceSessionType" json:"sessionType,omitempty"`
+	Template      string                               `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	ChannelType   GenerateVivoxTokenRequestChannelType `protobuf:"varint,4,opt,name=channelType,proto3,enum=service.GenerateVivoxTokenRequestChannelType" json:"channelType,omitempty"`
+	ChannelId     string                               `protobuf:"bytes,5,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Login         *GenerateVivoxTokenResponse          `protobuf:"bytes,6,opt,name=login,proto3" json:"login,omitempty"`
+	Join          *GenerateVivoxTokenResponse          `protobuf:"bytes,7,opt,name=join,proto3" json:"join,omitempty"`
+	TeamChannelId string                               `protobuf:"bytes,8,opt,name=teamChannelId,proto3" json:"teamChannelId,omitempty"`
+	TeamJoin      *GenerateVivoxTokenResponse          `protobuf:"bytes,9,opt,name=teamJoin,proto3" json:"teamJoin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinSessionVoiceResponse) Reset() {
+	*x = JoinSessionVoiceResponse{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinSessionVoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinSessionVoiceResponse) ProtoMessage() {}
+
+func (x *JoinSessionVoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinSessionVoiceResponse.ProtoReflect.Descriptor instead.
+func (*JoinSessionVoiceResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinSessionVoiceResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceResponse) GetSessionType() JoinSessionVoiceSessionType {
+	if x != nil {
+		return x.SessionType
+	}
+	return JoinSessionVoiceSessionType_joinsessionvoicesessiontype_unknown
+}
+
+func (x *JoinSessionVoiceResponse) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceResponse) GetChannelType() GenerateVivoxTokenRequestChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return GenerateVivoxTokenRequestChannelType_generatevivoxtokenrequest_channeltype_unknown
+}
+
+func (x *JoinSessionVoiceResponse) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceResponse) GetLogin() *GenerateVivoxTokenResponse {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+func (x *JoinSessionVoiceResponse) GetJoin() *GenerateVivoxTokenResponse {
+	if x != nil {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *JoinSessionVoiceResponse) GetTeamChannelId() string {
+	if x != nil {
+		return x.TeamChannelId
+	}
+	return ""
+}
+
+func (x *JoinSessionVoiceResponse) GetTeamJoin() *GenerateVivoxTokenResponse {
+	if x != nil {
+		return x.TeamJoin
+	}
+	return nil
+}
+
+type VerifyVivoxTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyVivoxTokenRequest) Reset() {
+	*x = VerifyVivoxTokenRequest{}
+	mi := &file_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyVivoxTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyVivoxTokenRequest) ProtoMessage() {}
+
+func (x *VerifyVivoxTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyVivoxTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyVivoxTokenRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyVivoxTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyVivoxTokenRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type VerifyVivoxTokenResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Valid         bool                          `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason        VerifyVivoxTokenFailureReason `protobuf:"varint,2,opt,name=reason,proto3,enum=service.VerifyVivoxTokenFailureReason" json:"reason,omitempty"`
+	Message       string                        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Claims        *VivoxTokenClaims             `protobuf:"bytes,4,opt,name=claims,proto3" json:"claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyVivoxTokenResponse) Reset() {
+	*x = VerifyVivoxTokenResponse{}
+	mi := &file_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyVivoxTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyVivoxTokenResponse) ProtoMessage() {}
+
+func (x *VerifyVivoxTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyVivoxTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyVivoxTokenResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VerifyVivoxTokenResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyVivoxTokenResponse) GetReason() VerifyVivoxTokenFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_none
+}
+
+func (x *VerifyVivoxTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyVivoxTokenResponse) GetClaims() *VivoxTokenClaims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type ResolveVivoxUriRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveVivoxUriRequest) Reset() {
+	*x = ResolveVivoxUriRequest{}
+	mi := &file_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveVivoxUriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveVivoxUriRequest) ProtoMessage() {}
+
+func (x *ResolveVivoxUriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveVivoxUriRequest.ProtoReflect.Descriptor instead.
+func (*ResolveVivoxUriRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveVivoxUriRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ResolveVivoxUriRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ResolveVivoxUriResponse struct {
+	state             protoimpl.MessageState                      `protogen:"open.v1"`
+	Kind              VivoxUriKind                                `protobuf:"varint,1,opt,name=kind,proto3,enum=service.VivoxUriKind" json:"kind,omitempty"`
+	Namespace         string                                      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Issuer            string                                      `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	UserId            string                                      `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	ChannelType       GenerateVivoxTokenRequestChannelType        `protobuf:"varint,5,opt,name=channelType,proto3,enum=service.GenerateVivoxTokenRequestChannelType" json:"channelType,omitempty"`
+	ChannelId         string                                      `protobuf:"bytes,6,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ChannelProperties *GenerateVivoxTokenRequestChannelProperties `protobuf:"bytes,7,opt,name=channelProperties,proto3" json:"channelProperties,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResolveVivoxUriResponse) Reset() {
+	*x = ResolveVivoxUriResponse{}
+	mi := &file_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveVivoxUriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveVivoxUriResponse) ProtoMessage() {}
+
+func (x *ResolveVivoxUriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveVivoxUriResponse.ProtoReflect.Descriptor instead.
+func (*ResolveVivoxUriResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveVivoxUriResponse) GetKind() VivoxUriKind {
+	if x != nil {
+		return x.Kind
+	}
+	return VivoxUriKind_vivoxurikind_unknown
+}
+
+func (x *ResolveVivoxUriResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResolveVivoxUriResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *ResolveVivoxUriResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResolveVivoxUriResponse) GetChannelType() GenerateVivoxTokenRequestChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return GenerateVivoxTokenRequestChannelType_generatevivoxtokenrequest_channeltype_unknown
+}
+
+func (x *ResolveVivoxUriResponse) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ResolveVivoxUriResponse) GetChannelProperties() *GenerateVivoxTokenRequestChannelProperties {
+	if x != nil {
+		return x.ChannelProperties
+	}
+	return nil
+}
+
+type ListVivoxSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVivoxSigningKeysRequest) Reset() {
+	*x = ListVivoxSigningKeysRequest{}
+	mi := &file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVivoxSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVivoxSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListVivoxSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVivoxSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListVivoxSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListVivoxSigningKeysRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListVivoxSigningKeysResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Namespace     string                    `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Keys          []*VivoxSigningKeyVersion `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	LoadedAt      int64                     `protobuf:"varint,3,opt,name=loadedAt,proto3" json:"loadedAt,omitempty"`
+	ReloadError   string                    `protobuf:"bytes,4,opt,name=reloadError,proto3" json:"reloadError,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVivoxSigningKeysResponse) Reset() {
+	*x = ListVivoxSigningKeysResponse{}
+	mi := &file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVivoxSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVivoxSigningKeysResponse) ProtoMessage() {}
+
+func (x *ListVivoxSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVivoxSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListVivoxSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListVivoxSigningKeysResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListVivoxSigningKeysResponse) GetKeys() []*VivoxSigningKeyVersion {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ListVivoxSigningKeysResponse) GetLoadedAt() int64 {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return 0
+}
+
+func (x *ListVivoxSigningKeysResponse) GetReloadError() string {
+	if x != nil {
+		return x.ReloadError
+	}
+	return ""
+}
+
+type VivoxSigningKeyVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	RetireAt      int64                  `protobuf:"varint,3,opt,name=retireAt,proto3" json:"retireAt,omitempty"`
+	Retired       bool                   `protobuf:"varint,4,opt,name=retired,proto3" json:"retired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VivoxSigningKeyVersion) Reset() {
+	*x = VivoxSigningKeyVersion{}
+	mi := &file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VivoxSigningKeyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VivoxSigningKeyVersion) ProtoMessage() {}
+
+func (x *VivoxSigningKeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VivoxSigningKeyVersion.ProtoReflect.Descriptor instead.
+func (*VivoxSigningKeyVersion) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VivoxSigningKeyVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VivoxSigningKeyVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *VivoxSigningKeyVersion) GetRetireAt() int64 {
+	if x != nil {
+		return x.RetireAt
+	}
+	return 0
+}
+
+func (x *VivoxSigningKeyVersion) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+type VivoxBan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BanId         string                 `protobuf:"bytes,1,opt,name=banId,proto3" json:"banId,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Scope         VivoxBanScope          `protobuf:"varint,4,opt,name=scope,proto3,enum=service.VivoxBanScope" json:"scope,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,5,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VivoxBan) Reset() {
+	*x = VivoxBan{}
+	mi := &file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VivoxBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VivoxBan) ProtoMessage() {}
+
+func (x *VivoxBan) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VivoxBan.ProtoReflect.Descriptor instead.
+func (*VivoxBan) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VivoxBan) GetBanId() string {
+	if x != nil {
+		return x.BanId
+	}
+	return ""
+}
+
+func (x *VivoxBan) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VivoxBan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VivoxBan) GetScope() VivoxBanScope {
+	if x != nil {
+		return x.Scope
+	}
+	return VivoxBanScope_vivoxbanscope_unknown
+}
+
+func (x *VivoxBan) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *VivoxBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VivoxBan) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VivoxBan) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *VivoxBan) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateVivoxBanRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Scope           VivoxBanScope          `protobuf:"varint,3,opt,name=scope,proto3,enum=service.VivoxBanScope" json:"scope,omitempty"`
+	ChannelId       string                 `protobuf:"bytes,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,7,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateVivoxBanRequest) Reset() {
+	*x = CreateVivoxBanRequest{}
+	mi := &file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVivoxBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVivoxBanRequest) ProtoMessage() {}
+
+func (x *CreateVivoxBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVivoxBanRequest.ProtoReflect.Descriptor instead.
+func (*CreateVivoxBanRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateVivoxBanRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateVivoxBanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateVivoxBanRequest) GetScope() VivoxBanScope {
+	if x != nil {
+		return x.Scope
+	}
+	return VivoxBanScope_vivoxbanscope_unknown
+}
+
+func (x *CreateVivoxBanRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateVivoxBanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateVivoxBanRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateVivoxBanRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CreateVivoxBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *VivoxBan              `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVivoxBanResponse) Reset() {
+	*x = CreateVivoxBanResponse{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVivoxBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVivoxBanResponse) ProtoMessage() {}
+
+func (x *CreateVivoxBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVivoxBanResponse.ProtoReflect.Descriptor instead.
+func (*CreateVivoxBanResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateVivoxBanResponse) GetBan() *VivoxBan {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type ListVivoxBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVivoxBansRequest) Reset() {
+	*x = ListVivoxBansRequest{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVivoxBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVivoxBansRequest) ProtoMessage() {}
+
+func (x *ListVivoxBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVivoxBansRequest.ProtoReflect.Descriptor instead.
+func (*ListVivoxBansRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListVivoxBansRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListVivoxBansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListVivoxBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*VivoxBan            `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVivoxBansResponse) Reset() {
+	*x = ListVivoxBansResponse{}
+	mi := &file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVivoxBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVivoxBansResponse) ProtoMessage() {}
+
+func (x *ListVivoxBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVivoxBansResponse.ProtoReflect.Descriptor instead.
+func (*ListVivoxBansResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListVivoxBansResponse) GetBans() []*VivoxBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type LiftVivoxBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	BanId         string                 `protobuf:"bytes,2,opt,name=banId,proto3" json:"banId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftVivoxBanRequest) Reset() {
+	*x = LiftVivoxBanRequest{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftVivoxBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftVivoxBanRequest) ProtoMessage() {}
+
+func (x *LiftVivoxBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftVivoxBanRequest.ProtoReflect.Descriptor instead.
+func (*LiftVivoxBanRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LiftVivoxBanRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LiftVivoxBanRequest) GetBanId() string {
+	if x != nil {
+		return x.BanId
+	}
+	return ""
+}
+
+type LiftVivoxBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *VivoxBan              `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftVivoxBanResponse) Reset() {
+	*x = LiftVivoxBanResponse{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftVivoxBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftVivoxBanResponse) ProtoMessage() {}
+
+func (x *LiftVivoxBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftVivoxBanResponse.ProtoReflect.Descriptor instead.
+func (*LiftVivoxBanResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LiftVivoxBanResponse) GetBan() *VivoxBan {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type VivoxTokenClaims struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vxi           int64                  `protobuf:"varint,1,opt,name=vxi,proto3" json:"vxi,omitempty"`
+	Sub           string                 `protobuf:"bytes,2,opt,name=sub,proto3" json:"sub,omitempty"`
+	F             string                 `protobuf:"bytes,3,opt,name=f,proto3" json:"f,omitempty"`
+	Iss           string                 `protobuf:"bytes,4,opt,name=iss,proto3" json:"iss,omitempty"`
+	Vxa           string                 `protobuf:"bytes,5,opt,name=vxa,proto3" json:"vxa,omitempty"`
+	T             string                 `protobuf:"bytes,6,opt,name=t,proto3" json:"t,omitempty"`
+	Exp           int64                  `protobuf:"varint,7,opt,name=exp,proto3" json:"exp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VivoxTokenClaims) Reset() {
+	*x = VivoxTokenClaims{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VivoxTokenClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VivoxTokenClaims) ProtoMessage() {}
+
+func (x *VivoxTokenClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VivoxTokenClaims.ProtoReflect.Descriptor instead.
+func (*VivoxTokenClaims) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VivoxTokenClaims) GetVxi() int64 {
+	if x != nil {
+		return x.Vxi
+	}
+	return 0
+}
+
+func (x *VivoxTokenClaims) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *VivoxTokenClaims) GetF() string {
+	if x != nil {
+		return x.F
+	}
+	return ""
+}
+
+func (x *VivoxTokenClaims) GetIss() string {
+	if x != nil {
+		return x.Iss
+	}
+	return ""
+}
+
+func (x *VivoxTokenClaims) GetVxa() string {
+	if x != nil {
+		return x.Vxa
+	}
+	return ""
+}
+
+func (x *VivoxTokenClaims) GetT() string {
+	if x != nil {
+		return x.T
+	}
+	return ""
+}
+
+func (x *VivoxTokenClaims) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+var File_service_proto protoreflect.FileDescriptor
+
+const file_service_proto_rawDesc = "" +
+	"\n" +
+	"\rservice.proto\x12\aservice\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x10permission.proto\"\xed\x06\n" +
+	"\x19GenerateVivoxTokenRequest\x12I\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.service.GenerateVivoxTokenRequestTypeB\r\x92A\n" +
+	"2\bRequiredR\x04type\x12)\n" +
+	"\busername\x18\x02 \x01(\tB\r\x92A\n" +
+	"2\bRequiredR\busername\x12Q\n" +
+	"\tchannelId\x18\x03 \x01(\tB3\x92A02.Required if type = join, mute or transcriptionR\tchannelId\x12\x8a\x01\n" +
+	"\vchannelType\x18\x04 \x01(\x0e2-.service.GenerateVivoxTokenRequestChannelTypeB9\x92A624Required if type = join, join_muted or transcriptionR\vchannelType\x12L\n" +
+	"\x0etargetUsername\x18\x05 \x01(\tB$\x92A!2\x1fRequired if type = kick or muteR\x0etargetUsername\x12\x93\x01\n" +
+	"\x11channelProperties\x18\x06 \x01(\v23.service.GenerateVivoxTokenRequestChannelPropertiesB0\x92A-2+Optional, only for channelType = positionalR\x11channelProperties\x12\\\n" +
+	"\tnamespace\x18\a \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\x12\x9e\x01\n" +
+	"\x10expiresInSeconds\x18\b \x01(\x05Br\x92Ao2mOptional, token lifetime within the bounds configured for the action type, defaults to the configured defaultR\x10expiresInSeconds:\x17\x92A\x14\n" +
+	"\x12\xd2\x01\x04type\xd2\x01\busername\"\xe4\x01\n" +
+	"\x1aGenerateVivoxTokensRequest\x12Z\n" +
+	"\tnamespace\x18\x01 \x01(\tB<\x92A927Optional, namespace of the tokens not setting their ownR\tnamespace\x12Z\n" +
+	"\x06tokens\x18\x02 \x03(\v2\".service.GenerateVivoxTokenRequestB\x1e\x92A\x1b2\x19Required, up to 16 tokensR\x06tokens:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06tokens\"\x93\x01\n" +
+	"\x1bGenerateVivoxTokensResponse\x12t\n" +
+	"\aresults\x18\x01 \x03(\v2\".service.GenerateVivoxTokensResultB6\x92A321One result per requested token, in the same orderR\aresults\"\xda\x01\n" +
+	"\x19GenerateVivoxTokensResult\x12_\n" +
+	"\x05token\x18\x01 \x01(\v2#.service.GenerateVivoxTokenResponseB$\x92A!2\x1fSet when the token is generatedR\x05token\x12\\\n" +
+	"\x05error\x18\x02 \x01(\v2!.service.GenerateVivoxTokensErrorB#\x92A 2\x1eSet when the token is rejectedR\x05error\"_\n" +
+	"\x18GenerateVivoxTokensError\x12)\n" +
+	"\x04code\x18\x01 \x01(\x05B\x15\x92A\x122\x10gRPC status codeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe8\x03\n" +
+	"*GenerateVivoxTokenRequestChannelProperties\x12\x88\x01\n" +
+	"\x0faudibleDistance\x18\x01 \x01(\x05B^\x92A[2YMaximum distance a speaker can be heard from, must be greater than conversationalDistanceR\x0faudibleDistance\x12y\n" +
+	"\x16conversationalDistance\x18\x02 \x01(\x05BA\x92A>2<Distance before audio starts to fade, must be greater than 0R\x16conversationalDistance\x12Z\n" +
+	"\rfadeIntensity\x18\x03 \x01(\x01B4\x92A12/Strength of the audio fade, between 0.1 and 2.0R\rfadeIntensity\x12X\n" +
+	"\tfadeModel\x18\x04 \x01(\x0e2+.service.GenerateVivoxTokenRequestFadeModelB\r\x92A\n" +
+	"2\bRequiredR\tfadeModel\"\xbf\x04\n" +
+	"\x1aGenerateVivoxTokenResponse\x12 \n" +
+	"\vaccessToken\x18\x01 \x01(\tR\vaccessToken\x12l\n" +
+	"\x03uri\x18\x02 \x01(\tBZ\x92AW2UChannel URI signed into the token, including channel properties. Join this exact URI.R\x03uri\x12>\n" +
+	"\texpiresAt\x18\x03 \x01(\x03B \x92A\x1d2\x1bUnix time the token expiresR\texpiresAt\x12P\n" +
+	"\afromUri\x18\x04 \x01(\tB6\x92A321User URI of the requesting user, use it to log inR\afromUri\x127\n" +
+	"\x05toUri\x18\x05 \x01(\tB!\x92A\x1e2\x1cChannel URI, empty for loginR\x05toUri\x12I\n" +
+	"\x06subUri\x18\x06 \x01(\tB1\x92A.2,User URI of the target user of kick and muteR\x06subUri\x12=\n" +
+	"\x06serial\x18\a \x01(\x03B%\x92A\"2 Serial number (vxi) of the tokenR\x06serial\x12<\n" +
+	"\x06action\x18\b \x01(\tB$\x92A!2\x1fVivox action (vxa) of the tokenR\x06action\"\x88\x04\n" +
+	"\x17JoinSessionVoiceRequest\x12\\\n" +
+	"\tnamespace\x18\x01 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\x12E\n" +
+	"\tsessionId\x18\x02 \x01(\tB'\x92A$2\"Required, game session or party IDR\tsessionId\x12K\n" +
+	"\busername\x18\x03 \x01(\tB/\x92A,2*Optional, defaults to the caller's user IDR\busername\x12t\n" +
+	"\vteamChannel\x18\x04 \x01(\bBR\x92AO2MAlso return a join token for the channel of the user's team in a game sessionR\vteamChannel\x12r\n" +
+	"\x10expiresInSeconds\x18\x05 \x01(\x05BF\x92AC2AOptional, lifetime of the tokens within the bounds of each actionR\x10expiresInSeconds:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\tsessionId\"\x8c\x05\n" +
+	"\x18JoinSessionVoiceResponse\x12\x1c\n" +
+	"\tsessionId\x18\x01 \x01(\tR\tsessionId\x12F\n" +
+	"\vsessionType\x18\x02 \x01(\x0e2$.service.JoinSessionVoiceSessionTypeR\vsessionType\x12K\n" +
+	"\btemplate\x18\x03 \x01(\tB/\x92A,2*Name of the session configuration templateR\btemplate\x12O\n" +
+	"\vchannelType\x18\x04 \x01(\x0e2-.service.GenerateVivoxTokenRequestChannelTypeR\vchannelType\x12\x1c\n" +
+	"\tchannelId\x18\x05 \x01(\tR\tchannelId\x129\n" +
+	"\x05login\x18\x06 \x01(\v2#.service.GenerateVivoxTokenResponseR\x05login\x127\n" +
+	"\x04join\x18\a \x01(\v2#.service.GenerateVivoxTokenResponseR\x04join\x12f\n" +
+	"\rteamChannelId\x18\b \x01(\tB@\x92A=2;Set when teamChannel is requested and the user is in a teamR\rteamChannelId\x12r\n" +
+	"\bteamJoin\x18\t \x01(\v2#.service.GenerateVivoxTokenResponseB1\x92A.2,Join token of the nonpositional team channelR\bteamJoin\"\xbd\x01\n" +
+	"\x17VerifyVivoxTokenRequest\x12/\n" +
+	"\vaccessToken\x18\x01 \x01(\tB\r\x92A\n" +
+	"2\bRequiredR\vaccessToken\x12\\\n" +
+	"\tnamespace\x18\x02 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace:\x13\x92A\x10\n" +
+	"\x0e\xd2\x01\vaccessToken\"\xff\x01\n" +
+	"\x18VerifyVivoxTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12>\n" +
+	"\x06reason\x18\x02 \x01(\x0e2&.service.VerifyVivoxTokenFailureReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12s\n" +
+	"\x06claims\x18\x04 \x01(\v2\x19.service.VivoxTokenClaimsB@\x92A=2;Decoded claims, present whenever the token could be decodedR\x06claims\"\xee\x01\n" +
+	"\x16ResolveVivoxUriRequest\x12i\n" +
+	"\x03uri\x18\x01 \x01(\tBW\x92AT2RRequired, e.g. sip:.issuer.userId.@domain or sip:confctl-g-issuer.channelId@domainR\x03uri\x12\\\n" +
+	"\tnamespace\x18\x02 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace:\v\x92A\b\n" +
+	"\x06\xd2\x01\x03uri\"\xcf\x04\n" +
+	"\x17ResolveVivoxUriResponse\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.service.VivoxUriKindR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12a\n" +
+	"\x06userId\x18\x04 \x01(\tBI\x92AF2DSet for user URIs, hashed IDs are returned as they appear in the URIR\x06userId\x12j\n" +
+	"\vchannelType\x18\x05 \x01(\x0e2-.service.GenerateVivoxTokenRequestChannelTypeB\x19\x92A\x162\x14Set for channel URIsR\vchannelType\x12j\n" +
+	"\tchannelId\x18\x06 \x01(\tBL\x92AI2GSet for channel URIs, hashed IDs are returned as they appear in the URIR\tchannelId\x12\x97\x01\n" +
+	"\x11channelProperties\x18\a \x01(\v23.service.GenerateVivoxTokenRequestChannelPropertiesB4\x92A12/Set for positional channel URIs with propertiesR\x11channelProperties\"{\n" +
+	"\x1bListVivoxSigningKeysRequest\x12\\\n" +
+	"\tnamespace\x18\x01 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\"\xa0\x02\n" +
+	"\x1cListVivoxSigningKeysResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x123\n" +
+	"\x04keys\x18\x02 \x03(\v2\x1f.service.VivoxSigningKeyVersionR\x04keys\x12?\n" +
+	"\bloadedAt\x18\x03 \x01(\x03B#\x92A 2\x1eUnix time the keys were loadedR\bloadedAt\x12l\n" +
+	"\vreloadError\x18\x04 \x01(\tBJ\x92AG2EError of the last reload of the key file, the loaded keys stay in useR\vreloadError\"\x83\x02\n" +
+	"\x16VivoxSigningKeyVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12H\n" +
+	"\x06active\x18\x02 \x01(\bB0\x92A-2+Whether new tokens are signed with this keyR\x06active\x12k\n" +
+	"\bretireAt\x18\x03 \x01(\x03BO\x92AL2JUnix time after which tokens signed with this key are rejected, 0 if neverR\bretireAt\x12\x18\n" +
+	"\aretired\x18\x04 \x01(\bR\aretired\"\xa9\x03\n" +
+	"\bVivoxBan\x12\x14\n" +
+	"\x05banId\x18\x01 \x01(\tR\x05banId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12,\n" +
+	"\x05scope\x18\x04 \x01(\x0e2\x16.service.VivoxBanScopeR\x05scope\x127\n" +
+	"\tchannelId\x18\x05 \x01(\tB\x19\x92A\x162\x14Set for channel bansR\tchannelId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12@\n" +
+	"\tcreatedAt\x18\a \x01(\x03B\"\x92A\x1f2\x1dUnix time the ban was createdR\tcreatedAt\x129\n" +
+	"\texpiresAt\x18\b \x01(\x03B\x1b\x92A\x182\x16Unix time the ban endsR\texpiresAt\x12U\n" +
+	"\tcreatedBy\x18\t \x01(\tB7\x92A422User or client ID of the admin who created the banR\tcreatedBy\"\xdc\x04\n" +
+	"\x15CreateVivoxBanRequest\x12\\\n" +
+	"\tnamespace\x18\x01 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\x12%\n" +
+	"\x06userId\x18\x02 \x01(\tB\r\x92A\n" +
+	"2\bRequiredR\x06userId\x12V\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x16.service.VivoxBanScopeB(\x92A%2#Required, global bans every channelR\x05scope\x12B\n" +
+	"\tchannelId\x18\x04 \x01(\tB$\x92A!2\x1fRequired if scope = channel_banR\tchannelId\x12?\n" +
+	"\x06reason\x18\x05 \x01(\tB'\x92A$2\"Optional, shown to the banned userR\x06reason\x12a\n" +
+	"\texpiresAt\x18\x06 \x01(\x03BC\x92A@2>Unix time the ban ends, required if durationSeconds is not setR\texpiresAt\x12f\n" +
+	"\x0fdurationSeconds\x18\a \x01(\x03B<\x92A927Ban duration from now, required if expiresAt is not setR\x0fdurationSeconds:\x16\x92A\x13\n" +
+	"\x11\xd2\x01\x06userId\xd2\x01\x05scope\"=\n" +
+	"\x16CreateVivoxBanResponse\x12#\n" +
+	"\x03ban\x18\x01 \x01(\v2\x11.service.VivoxBanR\x03ban\"\xbc\x01\n" +
+	"\x14ListVivoxBansRequest\x12\\\n" +
+	"\tnamespace\x18\x01 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\x12F\n" +
+	"\x06userId\x18\x02 \x01(\tB.\x92A+2)Optional, only list the bans of this userR\x06userId\">\n" +
+	"\x15ListVivoxBansResponse\x12%\n" +
+	"\x04bans\x18\x01 \x03(\v2\x11.service.VivoxBanR\x04bans\"\x98\x01\n" +
+	"\x13LiftVivoxBanRequest\x12\\\n" +
+	"\tnamespace\x18\x01 \x01(\tB>\x92A;29Optional, defaults to the namespace of the caller's tokenR\tnamespace\x12#\n" +
+	"\x05banId\x18\x02 \x01(\tB\r\x92A\n" +
+	"2\bRequiredR\x05banId\"P\n" +
+	"\x14LiftVivoxBanResponse\x128\n" +
+	"\x03ban\x18\x01 \x01(\v2\x11.service.VivoxBanB\x13\x92A\x102\x0eThe lifted banR\x03ban\"\x88\x01\n" +
+	"\x10VivoxTokenClaims\x12\x10\n" +
+	"\x03vxi\x18\x01 \x01(\x03R\x03vxi\x12\x10\n" +
+	"\x03sub\x18\x02 \x01(\tR\x03sub\x12\f\n" +
+	"\x01f\x18\x03 \x01(\tR\x01f\x12\x10\n" +
+	"\x03iss\x18\x04 \x01(\tR\x03iss\x12\x10\n" +
+	"\x03vxa\x18\x05 \x01(\tR\x03vxa\x12\f\n" +
+	"\x01t\x18\x06 \x01(\tR\x01t\x12\x10\n" +
+	"\x03exp\x18\a \x01(\x03R\x03exp*k\n" +
+	"\x1bJoinSessionVoiceSessionType\x12'\n" +
+	"#joinsessionvoicesessiontype_unknown\x10\x00\x12\x10\n" +
+	"\fgame_session\x10\x01\x12\x11\n" +
+	"\rparty_session\x10\x02*r\n" +
+	"\fVivoxUriKind\x12\x18\n" +
+	"\x14vivoxurikind_unknown\x10\x00\x12\x15\n" +
+	"\x11vivoxurikind_user\x10\x01\x12\x18\n" +
+	"\x14vivoxurikind_channel\x10\x02\x12\x17\n" +
+	"\x13vivoxurikind_server\x10\x03*K\n" +
+	"\rVivoxBanScope\x12\x19\n" +
+	"\x15vivoxbanscope_unknown\x10\x00\x12\x0e\n" +
+	"\n" +
+	"global_ban\x10\x01\x12\x0f\n" +
+	"\vchannel_ban\x10\x02*\x87\x02\n" +
+	"\x1dVerifyVivoxTokenFailureReason\x12&\n" +
+	"\"verifyvivoxtokenfailurereason_none\x10\x00\x12+\n" +
+	"'verifyvivoxtokenfailurereason_malformed\x10\x01\x123\n" +
+	"/verifyvivoxtokenfailurereason_invalid_signature\x10\x02\x12)\n" +
+	"%verifyvivoxtokenfailurereason_expired\x10\x03\x121\n" +
+	"-verifyvivoxtokenfailurereason_issuer_mismatch\x10\x04*\xa3\x03\n" +
+	"\x1dGenerateVivoxTokenRequestType\x12*\n" +
+	"&generatevivoxtokenrequest_type_unknown\x10\x00\x124\n" +
+	"\x05login\x10\x01\x1a)\x9a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\xa0\xb5\x18\x01\x123\n" +
+	"\x04join\x10\x02\x1a)\x9a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\xa0\xb5\x18\x01\x129\n" +
+	"\n" +
+	"join_muted\x10\x03\x1a)\x9a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\xa0\xb5\x18\x01\x128\n" +
+	"\x04kick\x10\x04\x1a.\x9a\xb5\x18&NAMESPACE:{namespace}:VIVOX:MODERATION\xa0\xb5\x18\x01\x128\n" +
+	"\x04mute\x10\x05\x1a.\x9a\xb5\x18&NAMESPACE:{namespace}:VIVOX:MODERATION\xa0\xb5\x18\x01\x12<\n" +
+	"\rtranscription\x10\x06\x1a)\x9a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\xa0\xb5\x18\x01*\x86\x01\n" +
+	"$GenerateVivoxTokenRequestChannelType\x121\n" +
+	"-generatevivoxtokenrequest_channeltype_unknown\x10\x00\x12\b\n" +
+	"\x04echo\x10\x01\x12\x0e\n" +
+	"\n" +
+	"positional\x10\x02\x12\x11\n" +
+	"\rnonpositional\x10\x03*\xa3\x01\n" +
+	"\"GenerateVivoxTokenRequestFadeModel\x12/\n" +
+	"+generatevivoxtokenrequest_fademodel_unknown\x10\x00\x12\x17\n" +
+	"\x13inverse_by_distance\x10\x01\x12\x16\n" +
+	"\x12linear_by_distance\x10\x02\x12\x1b\n" +
+	"\x17exponential_by_distance\x10\x032\xbe\x1a\n" +
+	"\aService\x12\xc1\x01\n" +
+	"\x12GenerateVivoxToken\x12\".service.GenerateVivoxTokenRequest\x1a#.service.GenerateVivoxTokenResponse\"b\x92A$\x12\x14Generate Vivox tokenb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x025:\x01*Z%:\x01*\" /v1/namespaces/{namespace}/token\"\t/v1/token\x12\xf3\x02\n" +
+	"\x13GenerateVivoxTokens\x12#.service.GenerateVivoxTokensRequest\x1a$.service.GenerateVivoxTokensResponse\"\x90\x02\x92A\xa6\x01\x12\x1eGenerate Vivox tokens in batch\x1avGenerate several Vivox tokens at once. Each token is validated independently, a failed token does not fail the others.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\x90\xb5\x18\x01\x82\xd3\xe4\x93\x027:\x01*Z&:\x01*\"!/v1/namespaces/{namespace}/tokens\"\n" +
+	"/v1/tokens\x12\xf1\x02\n" +
+	"\x11RefreshVivoxToken\x12\".service.GenerateVivoxTokenRequest\x1a#.service.GenerateVivoxTokenResponse\"\x90\x02\x92A\x98\x01\x12\x13Refresh Vivox token\x1asStream a freshly signed token shortly before the previous one expires, until the client cancels or loses permissionb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02E:\x01*Z-:\x01*\"(/v1/namespaces/{namespace}/token/refresh\"\x11/v1/token/refresh0\x01\x12\xaa\x02\n" +
+	"\x10VerifyVivoxToken\x12 .service.VerifyVivoxTokenRequest\x1a!.service.VerifyVivoxTokenResponse\"\xd0\x01\x92A[\x12\x12Verify Vivox token\x1a7Decode a Vivox token and check its signature and expiryb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02C:\x01*Z,:\x01*\"'/v1/namespaces/{namespace}/token/verify\"\x10/v1/token/verify\x12\xf0\x03\n" +
+	"\x10JoinSessionVoice\x12 .service.JoinSessionVoiceRequest\x1a!.service.JoinSessionVoiceResponse\"\x96\x03\x92A\x9e\x02\x12#Join the voice channel of a session\x1a\xe8\x01Look up an AccelByte game session or party the user is a member of, and return a login token and a join token for its channel. The channel ID and type are derived from the session template, so that all members join the same channel.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18!NAMESPACE:{namespace}:VIVOX:TOKEN\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02E:\x01*Z-:\x01*\"(/v1/namespaces/{namespace}/session/voice\"\x11/v1/session/voice\x12\xdd\x02\n" +
+	"\x0fResolveVivoxUri\x12\x1f.service.ResolveVivoxUriRequest\x1a .service.ResolveVivoxUriResponse\"\x86\x02\x92A\x8d\x01\x12\x11Resolve Vivox URI\x1ajParse a Vivox user, channel or server URI back into the AccelByte user ID or channel it was generated fromb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18&NAMESPACE:{namespace}:VIVOX:MODERATION\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02A:\x01*Z+:\x01*\"&/v1/namespaces/{namespace}/uri/resolve\"\x0f/v1/uri/resolve\x12\xd7\x02\n" +
+	"\x14ListVivoxSigningKeys\x12$.service.ListVivoxSigningKeysRequest\x1a%.service.ListVivoxSigningKeysResponse\"\xf1\x01\x92A\x81\x01\x12\x1fList Vivox signing key versions\x1aPList the loaded signing key versions of a namespace, without the keys themselvesb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18%ADMIN:NAMESPACE:{namespace}:VIVOX:KEY\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029Z'\x12%/v1/admin/namespaces/{namespace}/keys\x12\x0e/v1/admin/keys\x12\xf8\x02\n" +
+	"\x0eCreateVivoxBan\x12\x1e.service.CreateVivoxBanRequest\x1a\x1f.service.CreateVivoxBanResponse\"\xa4\x02\x92A\xae\x01\x12\x15Ban a user from voice\x1a\x86\x01Ban a user from every channel or from one channel until the ban expires. Tokens are no longer issued for the user in the banned scope.b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18%ADMIN:NAMESPACE:{namespace}:VIVOX:BAN\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02?:\x01*Z*:\x01*\"%/v1/admin/namespaces/{namespace}/bans\"\x0e/v1/admin/bans\x12\x99\x02\n" +
+	"\rListVivoxBans\x12\x1d.service.ListVivoxBansRequest\x1a\x1e.service.ListVivoxBansResponse\"\xc8\x01\x92AY\x12\x0fList voice bans\x1a8List the voice bans of a namespace that have not expiredb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18%ADMIN:NAMESPACE:{namespace}:VIVOX:BAN\x90\xb5\x18\x02\x82\xd3\xe4\x93\x029Z'\x12%/v1/admin/namespaces/{namespace}/bans\x12\x0e/v1/admin/bans\x12\x93\x02\n" +
+	"\fLiftVivoxBan\x12\x1c.service.LiftVivoxBanRequest\x1a\x1d.service.LiftVivoxBanResponse\"\xc5\x01\x92AF\x12\x10Lift a voice ban\x1a$Remove a voice ban before it expiresb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/tokenB\xbf\x01\x92AH\x12\x1b\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18%ADMIN:NAMESPACE:{namespace}:VIVOX:BAN\x90\xb5\x18\b\x82\xd3\xe4\x93\x02IZ/*-/v1/admin/namespaces/{namespace}/bans/{banId}*\x16/v1/admin/bans/{banId}B\xbf\x01\x92AH\x12\x1b\n" +
 	"\x14Vivox Authentication2\x031.0\"\b/serviceZ\x1f\n" +
 	"\x1d\n" +
 	"\x06Bearer\x12\x13\b\x02\x1a\rAuthorization \x02\n" +
@@ -314,24 +2256,91 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_service_proto_goTypes = []any{
-	(GenerateVivoxTokenRequestType)(0),        // 0: service.GenerateVivoxTokenRequestType
-	(GenerateVivoxTokenRequestChannelType)(0), // 1: service.GenerateVivoxTokenRequestChannelType
-	(*GenerateVivoxTokenRequest)(nil),         // 2: service.GenerateVivoxTokenRequest
-	(*GenerateVivoxTokenResponse)(nil),        // 3: service.GenerateVivoxTokenResponse
+	(JoinSessionVoiceSessionType)(0),                   // 0: service.JoinSessionVoiceSessionType
+	(VivoxUriKind)(0),                                  // 1: service.VivoxUriKind
+	(VivoxBanScope)(0),                                 // 2: service.VivoxBanScope
+	(VerifyVivoxTokenFailureReason)(0),                 // 3: service.VerifyVivoxTokenFailureReason
+	(GenerateVivoxTokenRequestType)(0),                 // 4: service.GenerateVivoxTokenRequestType
+	(GenerateVivoxTokenRequestChannelType)(0),          // 5: service.GenerateVivoxTokenRequestChannelType
+	(GenerateVivoxTokenRequestFadeModel)(0),            // 6: service.GenerateVivoxTokenRequestFadeModel
+	(*GenerateVivoxTokenRequest)(nil),                  // 7: service.GenerateVivoxTokenRequest
+	(*GenerateVivoxTokensRequest)(nil),                 // 8: service.GenerateVivoxTokensRequest
+	(*GenerateVivoxTokensResponse)(nil),                // 9: service.GenerateVivoxTokensResponse
+	(*GenerateVivoxTokensResult)(nil),                  // 10: service.GenerateVivoxTokensResult
+	(*GenerateVivoxTokensError)(nil),                   // 11: service.GenerateVivoxTokensError
+	(*GenerateVivoxTokenRequestChannelProperties)(nil), // 12: service.GenerateVivoxTokenRequestChannelProperties
+	(*GenerateVivoxTokenResponse)(nil),                 // 13: service.GenerateVivoxTokenResponse
+	(*JoinSessionVoiceRequest)(nil),                    // 14: service.JoinSessionVoiceRequest
+	(*JoinSessionVoiceResponse)(nil),                   // 15: service.JoinSessionVoiceResponse
+	(*VerifyVivoxTokenRequest)(nil),                    // 16: service.VerifyVivoxTokenRequest
+	(*VerifyVivoxTokenResponse)(nil),                   // 17: service.VerifyVivoxTokenResponse
+	(*ResolveVivoxUriRequest)(nil),                     // 18: service.ResolveVivoxUriRequest
+	(*ResolveVivoxUriResponse)(nil),                    // 19: service.ResolveVivoxUriResponse
+	(*ListVivoxSigningKeysRequest)(nil),                // 20: service.ListVivoxSigningKeysRequest
+	(*ListVivoxSigningKeysResponse)(nil),               // 21: service.ListVivoxSigningKeysResponse
+	(*VivoxSigningKeyVersion)(nil),                     // 22: service.VivoxSigningKeyVersion
+	(*VivoxBan)(nil),                                   // 23: service.VivoxBan
+	(*CreateVivoxBanRequest)(nil),                      // 24: service.CreateVivoxBanRequest
+	(*CreateVivoxBanResponse)(nil),                     // 25: service.CreateVivoxBanResponse
+	(*ListVivoxBansRequest)(nil),                       // 26: service.ListVivoxBansRequest
+	(*ListVivoxBansResponse)(nil),                      // 27: service.ListVivoxBansResponse
+	(*LiftVivoxBanRequest)(nil),                        // 28: service.LiftVivoxBanRequest
+	(*LiftVivoxBanResponse)(nil),                       // 29: service.LiftVivoxBanResponse
+	(*VivoxTokenClaims)(nil),                           // 30: service.VivoxTokenClaims
 }
 var file_service_proto_depIdxs = []int32{
-	0, // 0: service.GenerateVivoxTokenRequest.type:type_name -> service.GenerateVivoxTokenRequestType
-	1, // 1: service.GenerateVivoxTokenRequest.channelType:type_name -> service.GenerateVivoxTokenRequestChannelType
-	2, // 2: service.Service.GenerateVivoxToken:input_type -> service.GenerateVivoxTokenRequest
-	3, // 3: service.Service.GenerateVivoxToken:output_type -> service.GenerateVivoxTokenResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4,  // 0: service.GenerateVivoxTokenRequest.type:type_name -> service.GenerateVivoxTokenRequestType
+	5,  // 1: service.GenerateVivoxTokenRequest.channelType:type_name -> service.GenerateVivoxTokenRequestChannelType
+	12, // 2: service.GenerateVivoxTokenRequest.channelProperties:type_name -> service.GenerateVivoxTokenRequestChannelProperties
+	7,  // 3: service.GenerateVivoxTokensRequest.tokens:type_name -> service.GenerateVivoxTokenRequest
+	10, // 4: service.GenerateVivoxTokensResponse.results:type_name -> service.GenerateVivoxTokensResult
+	13, // 5: service.GenerateVivoxTokensResult.token:type_name -> service.GenerateVivoxTokenResponse
+	11, // 6: service.GenerateVivoxTokensResult.error:type_name -> service.GenerateVivoxTokensError
+	6,  // 7: service.GenerateVivoxTokenRequestChannelProperties.fadeModel:type_name -> service.GenerateVivoxTokenRequestFadeModel
+	0,  // 8: service.JoinSessionVoiceResponse.sessionType:type_name -> service.JoinSessionVoiceSessionType
+	5,  // 9: service.JoinSessionVoiceResponse.channelType:type_name -> service.GenerateVivoxTokenRequestChannelType
+	13, // 10: service.JoinSessionVoiceResponse.login:type_name -> service.GenerateVivoxTokenResponse
+	13, // 11: service.JoinSessionVoiceResponse.join:type_name -> service.GenerateVivoxTokenResponse
+	13, // 12: service.JoinSessionVoiceResponse.teamJoin:type_name -> service.GenerateVivoxTokenResponse
+	3,  // 13: service.VerifyVivoxTokenResponse.reason:type_name -> service.VerifyVivoxTokenFailureReason
+	30, // 14: service.VerifyVivoxTokenResponse.claims:type_name -> service.VivoxTokenClaims
+	1,  // 15: service.ResolveVivoxUriResponse.kind:type_name -> service.VivoxUriKind
+	5,  // 16: service.ResolveVivoxUriResponse.channelType:type_name -> service.GenerateVivoxTokenRequestChannelType
+	12, // 17: service.ResolveVivoxUriResponse.channelProperties:type_name -> service.GenerateVivoxTokenRequestChannelProperties
+	22, // 18: service.ListVivoxSigningKeysResponse.keys:type_name -> service.VivoxSigningKeyVersion
+	2,  // 19: service.VivoxBan.scope:type_name -> service.VivoxBanScope
+	2,  // 20: service.CreateVivoxBanRequest.scope:type_name -> service.VivoxBanScope
+	23, // 21: service.CreateVivoxBanResponse.ban:type_name -> service.VivoxBan
+	23, // 22: service.ListVivoxBansResponse.bans:type_name -> service.VivoxBan
+	23, // 23: service.LiftVivoxBanResponse.ban:type_name -> service.VivoxBan
+	7,  // 24: service.Service.GenerateVivoxToken:input_type -> service.GenerateVivoxTokenRequest
+	8,  // 25: service.Service.GenerateVivoxTokens:input_type -> service.GenerateVivoxTokensRequest
+	7,  // 26: service.Service.RefreshVivoxToken:input_type -> service.GenerateVivoxTokenRequest
+	16, // 27: service.Service.VerifyVivoxToken:input_type -> service.VerifyVivoxTokenRequest
+	14, // 28: service.Service.JoinSessionVoice:input_type -> service.JoinSessionVoiceRequest
+	18, // 29: service.Service.ResolveVivoxUri:input_type -> service.ResolveVivoxUriRequest
+	20, // 30: service.Service.ListVivoxSigningKeys:input_type -> service.ListVivoxSigningKeysRequest
+	24, // 31: service.Service.CreateVivoxBan:input_type -> service.CreateVivoxBanRequest
+	26, // 32: service.Service.ListVivoxBans:input_type -> service.ListVivoxBansRequest
+	28, // 33: service.Service.LiftVivoxBan:input_type -> service.LiftVivoxBanRequest
+	13, // 34: service.Service.GenerateVivoxToken:output_type -> service.GenerateVivoxTokenResponse
+	9,  // 35: service.Service.GenerateVivoxTokens:output_type -> service.GenerateVivoxTokensResponse
+	13, // 36: service.Service.RefreshVivoxToken:output_type -> service.GenerateVivoxTokenResponse
+	17, // 37: service.Service.VerifyVivoxToken:output_type -> service.VerifyVivoxTokenResponse
+	15, // 38: service.Service.JoinSessionVoice:output_type -> service.JoinSessionVoiceResponse
+	19, // 39: service.Service.ResolveVivoxUri:output_type -> service.ResolveVivoxUriResponse
+	21, // 40: service.Service.ListVivoxSigningKeys:output_type -> service.ListVivoxSigningKeysResponse
+	25, // 41: service.Service.CreateVivoxBan:output_type -> service.CreateVivoxBanResponse
+	27, // 42: service.Service.ListVivoxBans:output_type -> service.ListVivoxBansResponse
+	29, // 43: service.Service.LiftVivoxBan:output_type -> service.LiftVivoxBanResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -345,8 +2354,8 @@ func file_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      7,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
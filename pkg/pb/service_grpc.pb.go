@@ -23,7 +23,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Service_GenerateVivoxToken_FullMethodName = "/service.Service/GenerateVivoxToken"
+	Service_GenerateVivoxToken_FullMethodName   = "/service.Service/GenerateVivoxToken"
+	Service_GenerateVivoxTokens_FullMethodName  = "/service.Service/GenerateVivoxTokens"
+	Service_RefreshVivoxToken_FullMethodName    = "/service.Service/RefreshVivoxToken"
+	Service_VerifyVivoxToken_FullMethodName     = "/service.Service/VerifyVivoxToken"
+	Service_JoinSessionVoice_FullMethodName     = "/service.Service/JoinSessionVoice"
+	Service_ResolveVivoxUri_FullMethodName      = "/service.Service/ResolveVivoxUri"
+	Service_ListVivoxSigningKeys_FullMethodName = "/service.Service/ListVivoxSigningKeys"
+	Service_CreateVivoxBan_FullMethodName       = "/service.Service/CreateVivoxBan"
+	Service_ListVivoxBans_FullMethodName        = "/service.Service/ListVivoxBans"
+	Service_LiftVivoxBan_FullMethodName         = "/service.Service/LiftVivoxBan"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +40,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	GenerateVivoxToken(ctx context.Context, in *GenerateVivoxTokenRequest, opts ...grpc.CallOption) (*GenerateVivoxTokenResponse, error)
+	GenerateVivoxTokens(ctx context.Context, in *GenerateVivoxTokensRequest, opts ...grpc.CallOption) (*GenerateVivoxTokensResponse, error)
+	RefreshVivoxToken(ctx context.Context, in *GenerateVivoxTokenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateVivoxTokenResponse], error)
+	VerifyVivoxToken(ctx context.Context, in *VerifyVivoxTokenRequest, opts ...grpc.CallOption) (*VerifyVivoxTokenResponse, error)
+	JoinSessionVoice(ctx context.Context, in *JoinSessionVoiceRequest, opts ...grpc.CallOption) (*JoinSessionVoiceResponse, error)
+	ResolveVivoxUri(ctx context.Context, in *ResolveVivoxUriRequest, opts ...grpc.CallOption) (*ResolveVivoxUriResponse, error)
+	ListVivoxSigningKeys(ctx context.Context, in *ListVivoxSigningKeysRequest, opts ...grpc.CallOption) (*ListVivoxSigningKeysResponse, error)
+	CreateVivoxBan(ctx context.Context, in *CreateVivoxBanRequest, opts ...grpc.CallOption) (*CreateVivoxBanResponse, error)
+	ListVivoxBans(ctx context.Context, in *ListVivoxBansRequest, opts ...grpc.CallOption) (*ListVivoxBansResponse, error)
+	LiftVivoxBan(ctx context.Context, in *LiftVivoxBanRequest, opts ...grpc.CallOption) (*LiftVivoxBanResponse, error)
 }
 
 type serviceClient struct {
@@ -51,11 +69,119 @@ func (c *serviceClient) GenerateVivoxToken(ctx context.Context, in *GenerateVivo
 	return out, nil
 }
 
+func (c *serviceClient) GenerateVivoxTokens(ctx context.Context, in *GenerateVivoxTokensRequest, opts ...grpc.CallOption) (*GenerateVivoxTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateVivoxTokensResponse)
+	err := c.cc.Invoke(ctx, Service_GenerateVivoxTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RefreshVivoxToken(ctx context.Context, in *GenerateVivoxTokenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateVivoxTokenResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_RefreshVivoxToken_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateVivoxTokenRequest, GenerateVivoxTokenResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Service_RefreshVivoxTokenClient = grpc.ServerStreamingClient[GenerateVivoxTokenResponse]
+
+func (c *serviceClient) VerifyVivoxToken(ctx context.Context, in *VerifyVivoxTokenRequest, opts ...grpc.CallOption) (*VerifyVivoxTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyVivoxTokenResponse)
+	err := c.cc.Invoke(ctx, Service_VerifyVivoxToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) JoinSessionVoice(ctx context.Context, in *JoinSessionVoiceRequest, opts ...grpc.CallOption) (*JoinSessionVoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinSessionVoiceResponse)
+	err := c.cc.Invoke(ctx, Service_JoinSessionVoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveVivoxUri(ctx context.Context, in *ResolveVivoxUriRequest, opts ...grpc.CallOption) (*ResolveVivoxUriResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveVivoxUriResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveVivoxUri_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListVivoxSigningKeys(ctx context.Context, in *ListVivoxSigningKeysRequest, opts ...grpc.CallOption) (*ListVivoxSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVivoxSigningKeysResponse)
+	err := c.cc.Invoke(ctx, Service_ListVivoxSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateVivoxBan(ctx context.Context, in *CreateVivoxBanRequest, opts ...grpc.CallOption) (*CreateVivoxBanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVivoxBanResponse)
+	err := c.cc.Invoke(ctx, Service_CreateVivoxBan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListVivoxBans(ctx context.Context, in *ListVivoxBansRequest, opts ...grpc.CallOption) (*ListVivoxBansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVivoxBansResponse)
+	err := c.cc.Invoke(ctx, Service_ListVivoxBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) LiftVivoxBan(ctx context.Context, in *LiftVivoxBanRequest, opts ...grpc.CallOption) (*LiftVivoxBanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LiftVivoxBanResponse)
+	err := c.cc.Invoke(ctx, Service_LiftVivoxBan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations should embed UnimplementedServiceServer
 // for forward compatibility.
 type ServiceServer interface {
 	GenerateVivoxToken(context.Context, *GenerateVivoxTokenRequest) (*GenerateVivoxTokenResponse, error)
+	GenerateVivoxTokens(context.Context, *GenerateVivoxTokensRequest) (*GenerateVivoxTokensResponse, error)
+	RefreshVivoxToken(*GenerateVivoxTokenRequest, grpc.ServerStreamingServer[GenerateVivoxTokenResponse]) error
+	VerifyVivoxToken(context.Context, *VerifyVivoxTokenRequest) (*VerifyVivoxTokenResponse, error)
+	JoinSessionVoice(context.Context, *JoinSessionVoiceRequest) (*JoinSessionVoiceResponse, error)
+	ResolveVivoxUri(context.Context, *ResolveVivoxUriRequest) (*ResolveVivoxUriResponse, error)
+	ListVivoxSigningKeys(context.Context, *ListVivoxSigningKeysRequest) (*ListVivoxSigningKeysResponse, error)
+	CreateVivoxBan(context.Context, *CreateVivoxBanRequest) (*CreateVivoxBanResponse, error)
+	ListVivoxBans(context.Context, *ListVivoxBansRequest) (*ListVivoxBansResponse, error)
+	LiftVivoxBan(context.Context, *LiftVivoxBanRequest) (*LiftVivoxBanResponse, error)
 }
 
 // UnimplementedServiceServer should be embedded to have
@@ -68,6 +194,33 @@ type UnimplementedServiceServer struct{}
 func (UnimplementedServiceServer) GenerateVivoxToken(context.Context, *GenerateVivoxTokenRequest) (*GenerateVivoxTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateVivoxToken not implemented")
 }
+func (UnimplementedServiceServer) GenerateVivoxTokens(context.Context, *GenerateVivoxTokensRequest) (*GenerateVivoxTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateVivoxTokens not implemented")
+}
+func (UnimplementedServiceServer) RefreshVivoxToken(*GenerateVivoxTokenRequest, grpc.ServerStreamingServer[GenerateVivoxTokenResponse]) error {
+	return status.Error(codes.Unimplemented, "method RefreshVivoxToken not implemented")
+}
+func (UnimplementedServiceServer) VerifyVivoxToken(context.Context, *VerifyVivoxTokenRequest) (*VerifyVivoxTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyVivoxToken not implemented")
+}
+func (UnimplementedServiceServer) JoinSessionVoice(context.Context, *JoinSessionVoiceRequest) (*JoinSessionVoiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinSessionVoice not implemented")
+}
+func (UnimplementedServiceServer) ResolveVivoxUri(context.Context, *ResolveVivoxUriRequest) (*ResolveVivoxUriResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveVivoxUri not implemented")
+}
+func (UnimplementedServiceServer) ListVivoxSigningKeys(context.Context, *ListVivoxSigningKeysRequest) (*ListVivoxSigningKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVivoxSigningKeys not implemented")
+}
+func (UnimplementedServiceServer) CreateVivoxBan(context.Context, *CreateVivoxBanRequest) (*CreateVivoxBanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVivoxBan not implemented")
+}
+func (UnimplementedServiceServer) ListVivoxBans(context.Context, *ListVivoxBansRequest) (*ListVivoxBansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVivoxBans not implemented")
+}
+func (UnimplementedServiceServer) LiftVivoxBan(context.Context, *LiftVivoxBanRequest) (*LiftVivoxBanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LiftVivoxBan not implemented")
+}
 func (UnimplementedServiceServer) testEmbeddedByValue() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -106,6 +259,161 @@ func _Service_GenerateVivoxToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GenerateVivoxTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateVivoxTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GenerateVivoxTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GenerateVivoxTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GenerateVivoxTokens(ctx, req.(*GenerateVivoxTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RefreshVivoxToken_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateVivoxTokenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).RefreshVivoxToken(m, &grpc.GenericServerStream[GenerateVivoxTokenRequest, GenerateVivoxTokenResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Service_RefreshVivoxTokenServer = grpc.ServerStreamingServer[GenerateVivoxTokenResponse]
+
+func _Service_VerifyVivoxToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyVivoxTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerifyVivoxToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_VerifyVivoxToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerifyVivoxToken(ctx, req.(*VerifyVivoxTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_JoinSessionVoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinSessionVoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).JoinSessionVoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_JoinSessionVoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).JoinSessionVoice(ctx, req.(*JoinSessionVoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveVivoxUri_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveVivoxUriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveVivoxUri(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveVivoxUri_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveVivoxUri(ctx, req.(*ResolveVivoxUriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListVivoxSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVivoxSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListVivoxSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListVivoxSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListVivoxSigningKeys(ctx, req.(*ListVivoxSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateVivoxBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVivoxBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateVivoxBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CreateVivoxBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateVivoxBan(ctx, req.(*CreateVivoxBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListVivoxBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVivoxBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListVivoxBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListVivoxBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListVivoxBans(ctx, req.(*ListVivoxBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_LiftVivoxBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftVivoxBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LiftVivoxBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_LiftVivoxBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LiftVivoxBan(ctx, req.(*LiftVivoxBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,7 +425,45 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateVivoxToken",
 			Handler:    _Service_GenerateVivoxToken_Handler,
 		},
+		{
+			MethodName: "GenerateVivoxTokens",
+			Handler:    _Service_GenerateVivoxTokens_Handler,
+		},
+		{
+			MethodName: "VerifyVivoxToken",
+			Handler:    _Service_VerifyVivoxToken_Handler,
+		},
+		{
+			MethodName: "JoinSessionVoice",
+			Handler:    _Service_JoinSessionVoice_Handler,
+		},
+		{
+			MethodName: "ResolveVivoxUri",
+			Handler:    _Service_ResolveVivoxUri_Handler,
+		},
+		{
+			MethodName: "ListVivoxSigningKeys",
+			Handler:    _Service_ListVivoxSigningKeys_Handler,
+		},
+		{
+			MethodName: "CreateVivoxBan",
+			Handler:    _Service_CreateVivoxBan_Handler,
+		},
+		{
+			MethodName: "ListVivoxBans",
+			Handler:    _Service_ListVivoxBans_Handler,
+		},
+		{
+			MethodName: "LiftVivoxBan",
+			Handler:    _Service_LiftVivoxBan_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RefreshVivoxToken",
+			Handler:       _Service_RefreshVivoxToken_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
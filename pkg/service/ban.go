@@ -0,0 +1,264 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// BanScope is the extent of a voice ban
+type BanScope string
+
+const (
+	BanScopeGlobal  BanScope = "global"  // every channel, and logins
+	BanScopeChannel BanScope = "channel" // a single channel ID
+)
+
+// Ban keeps a user from being issued Vivox tokens until it expires
+type Ban struct {
+	ID        string    `json:"id"`
+	Namespace string    `json:"namespace"`
+	UserID    string    `json:"userId"`
+	Scope     BanScope  `json:"scope"`
+	ChannelID string    `json:"channelId,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+}
+
+// Active reports whether the ban is still in effect at now
+func (b Ban) Active(now time.Time) bool {
+	return now.Before(b.ExpiresAt)
+}
+
+// Covers reports whether the ban applies to a token for channelID, empty for tokens without channel
+func (b Ban) Covers(channelID string) bool {
+	return b.Scope == BanScopeGlobal || (channelID != "" && b.ChannelID == channelID)
+}
+
+// ErrBanNotFound is returned when lifting a ban that does not exist or already expired
+var ErrBanNotFound = errors.New("voice ban not found")
+
+// BanStore persists voice bans. Expired bans are never returned.
+type BanStore interface {
+	// Create stores ban, its ID must be unique
+	Create(ctx context.Context, ban Ban) error
+	// List returns the active bans of namespace, only those of userID when it is set, oldest first
+	List(ctx context.Context, namespace, userID string) ([]Ban, error)
+	// Delete removes the ban id of namespace and returns it
+	Delete(ctx context.Context, namespace, id string) (Ban, error)
+}
+
+// newBanID returns a random ban ID
+func newBanID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generate ban id")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// MemoryBanStore keeps the bans in memory, they are lost on restart
+type MemoryBanStore struct {
+	mu   sync.Mutex
+	bans map[string]Ban
+	now  func() time.Time
+}
+
+// NewMemoryBanStore returns an empty in-memory store
+func NewMemoryBanStore() *MemoryBanStore {
+	return &MemoryBanStore{bans: map[string]Ban{}, now: time.Now}
+}
+
+func (s *MemoryBanStore) Create(_ context.Context, ban Ban) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.create(ban)
+}
+
+func (s *MemoryBanStore) List(_ context.Context, namespace, userID string) ([]Ban, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var bans []Ban
+	for _, ban := range s.bans {
+		if ban.Namespace == namespace && (userID == "" || ban.UserID == userID) && ban.Active(now) {
+			bans = append(bans, ban)
+		}
+	}
+	sortBans(bans)
+
+	return bans, nil
+}
+
+func (s *MemoryBanStore) Delete(_ context.Context, namespace, id string) (Ban, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.delete(namespace, id)
+}
+
+func (s *MemoryBanStore) create(ban Ban) error {
+	if _, exists := s.bans[ban.ID]; exists {
+		return errors.Errorf("voice ban %s already exists", ban.ID)
+	}
+	s.prune()
+	s.bans[ban.ID] = ban
+
+	return nil
+}
+
+func (s *MemoryBanStore) delete(namespace, id string) (Ban, error) {
+	ban, found := s.bans[id]
+	if !found || ban.Namespace != namespace || !ban.Active(s.now()) {
+		return Ban{}, errors.Wrapf(ErrBanNotFound, "ban %s", id)
+	}
+	delete(s.bans, id)
+	s.prune()
+
+	return ban, nil
+}
+
+// prune drops the expired bans
+func (s *MemoryBanStore) prune() {
+	now := s.now()
+	for id, ban := range s.bans {
+		if !ban.Active(now) {
+			delete(s.bans, id)
+		}
+	}
+}
+
+// snapshot returns all stored bans, oldest first
+func (s *MemoryBanStore) snapshot() []Ban {
+	bans := make([]Ban, 0, len(s.bans))
+	for _, ban := range s.bans {
+		bans = append(bans, ban)
+	}
+	sortBans(bans)
+
+	return bans
+}
+
+func sortBans(bans []Ban) {
+	sort.Slice(bans, func(i, j int) bool {
+		if !bans[i].CreatedAt.Equal(bans[j].CreatedAt) {
+			return bans[i].CreatedAt.Before(bans[j].CreatedAt)
+		}
+
+		return bans[i].ID < bans[j].ID
+	})
+}
+
+// FileBanStore keeps the bans in memory and writes them all to a JSON file on every change,
+// so that they survive restarts. The file is replaced atomically and must not be shared by replicas.
+type FileBanStore struct {
+	*MemoryBanStore
+	path string
+}
+
+// NewFileBanStore loads the bans of the file at path, which is created on the first change when missing
+func NewFileBanStore(path string) (*FileBanStore, error) {
+	s := &FileBanStore{MemoryBanStore: NewMemoryBanStore(), path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "read ban file")
+	}
+
+	var file struct {
+		Bans []Ban `json:"bans"`
+	}
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "parse ban file")
+	}
+	for _, ban := range file.Bans {
+		s.bans[ban.ID] = ban
+	}
+	s.prune()
+
+	return s, nil
+}
+
+func (s *FileBanStore) Create(_ context.Context, ban Ban) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.create(ban); err != nil {
+		return err
+	}
+	if err := s.save(); err != nil {
+		delete(s.bans, ban.ID)
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *FileBanStore) Delete(_ context.Context, namespace, id string) (Ban, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ban, err := s.delete(namespace, id)
+	if err != nil {
+		return Ban{}, err
+	}
+	if err = s.save(); err != nil {
+		s.bans[ban.ID] = ban
+
+		return Ban{}, err
+	}
+
+	return ban, nil
+}
+
+// save writes all bans to a temporary file renamed over the ban file
+func (s *FileBanStore) save() error {
+	data, err := json.MarshalIndent(struct {
+		Bans []Ban `json:"bans"`
+	}{Bans: s.snapshot()}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encode ban file")
+	}
+
+	dir := filepath.Dir(s.path)
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrap(err, "create ban directory")
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "create ban file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "write ban file")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), s.path), "replace ban file")
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBan(id, namespace, userID string, expiresAt time.Time) Ban {
+	return Ban{
+		ID:        id,
+		Namespace: namespace,
+		UserID:    userID,
+		Scope:     BanScopeGlobal,
+		Reason:    "spam",
+		CreatedAt: expiresAt.Add(-time.Hour),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func TestMemoryBanStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryBanStore()
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Create(ctx, testBan("b2", "game", "griefer", now.Add(2*time.Hour))))
+	require.NoError(t, store.Create(ctx, testBan("b1", "game", "griefer", now.Add(time.Hour))))
+	require.NoError(t, store.Create(ctx, testBan("b3", "game", "player", now.Add(time.Hour))))
+	require.NoError(t, store.Create(ctx, testBan("b4", "other", "griefer", now.Add(time.Hour))))
+	require.NoError(t, store.Create(ctx, testBan("b5", "game", "griefer", now.Add(-time.Minute))))
+	require.Error(t, store.Create(ctx, testBan("b1", "game", "player", now.Add(time.Hour))), "IDs are unique")
+
+	bans, err := store.List(ctx, "game", "griefer")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1", "b2"}, banIDs(bans), "oldest first, without expired bans")
+
+	bans, err = store.List(ctx, "game", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1", "b3", "b2"}, banIDs(bans))
+
+	_, err = store.Delete(ctx, "other", "b1")
+	require.ErrorIs(t, err, ErrBanNotFound, "bans are only lifted in their namespace")
+	_, err = store.Delete(ctx, "game", "b5")
+	require.ErrorIs(t, err, ErrBanNotFound, "expired bans are gone")
+
+	lifted, err := store.Delete(ctx, "game", "b1")
+	require.NoError(t, err)
+	assert.Equal(t, "griefer", lifted.UserID)
+	bans, err = store.List(ctx, "game", "griefer")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b2"}, banIDs(bans))
+}
+
+func TestFileBanStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "bans", "bans.json")
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	store, err := NewFileBanStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, testBan("b1", "game", "griefer", expiresAt)))
+	require.NoError(t, store.Create(ctx, testBan("b2", "game", "player", expiresAt)))
+	_, err = store.Delete(ctx, "game", "b2")
+	require.NoError(t, err)
+
+	// the bans survive a restart
+	store, err = NewFileBanStore(path)
+	require.NoError(t, err)
+	bans, err := store.List(ctx, "game", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"b1"}, banIDs(bans))
+	assert.Equal(t, testBan("b1", "game", "griefer", expiresAt), bans[0])
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = NewFileBanStore(path)
+	require.ErrorContains(t, err, "parse ban file")
+}
+
+func banIDs(bans []Ban) []string {
+	ids := make([]string, 0, len(bans))
+	for _, ban := range bans {
+		ids = append(ids, ban.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	utils "extend-rtu-vivox-authorization-service/pkg/common"
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/session"
+	"github.com/AccelByte/accelbyte-go-sdk/session-sdk/pkg/sessionclient/game_session"
+	"github.com/AccelByte/accelbyte-go-sdk/session-sdk/pkg/sessionclient/party"
+	"github.com/AccelByte/accelbyte-go-sdk/session-sdk/pkg/sessionclientmodels"
+	"github.com/pkg/errors"
+)
+
+// ErrChannelAccessDenied is returned by a ChannelAuthorizer when the user may not be issued a token for the channel
+var ErrChannelAccessDenied = errors.New("channel access denied")
+
+// ChannelAuthorizer decides whether a user may be issued a token for a channel of channelType, for every action but
+// login: join, join_muted and transcription, and kick or mute of targetUserID.
+// It returns an error wrapping ErrChannelAccessDenied when the user may not, other errors when it cannot tell.
+type ChannelAuthorizer interface {
+	AuthorizeChannel(
+		ctx context.Context, namespace, userID, targetUserID, channelID string,
+		channelType pb.GenerateVivoxTokenRequestChannelType, action pb.GenerateVivoxTokenRequestType,
+	) error
+}
+
+// ChannelAuthorizerFunc adapts a function to a ChannelAuthorizer
+type ChannelAuthorizerFunc func(
+	ctx context.Context, namespace, userID, targetUserID, channelID string,
+	channelType pb.GenerateVivoxTokenRequestChannelType, action pb.GenerateVivoxTokenRequestType,
+) error
+
+func (f ChannelAuthorizerFunc) AuthorizeChannel(
+	ctx context.Context, namespace, userID, targetUserID, channelID string,
+	channelType pb.GenerateVivoxTokenRequestChannelType, action pb.GenerateVivoxTokenRequestType,
+) error {
+	return f(ctx, namespace, userID, targetUserID, channelID, channelType, action)
+}
+
+// Kinds of AccelByte sessions
+const (
+	SessionKindGame  = "game"
+	SessionKindParty = "party"
+)
+
+// memberStatuses are the session member statuses of the users currently in a session
+var memberStatuses = []string{"JOINED", "CONNECTED"}
+
+// Session is an AccelByte game session or party, as far as voice channels are concerned
+type Session struct {
+	ID       string
+	Kind     string // SessionKindGame or SessionKindParty
+	Template string // name of the session configuration template
+	LeaderID string
+	Members  []SessionMember
+	Teams    [][]string // user IDs of each team, game sessions only
+}
+
+// SessionMember is a user invited to or in a session, with their member status, e.g. JOINED
+type SessionMember struct {
+	UserID string
+	Status string
+}
+
+// IsCurrentMember reports whether userID has joined the session and not left it
+func (s *Session) IsCurrentMember(userID string) bool {
+	for _, member := range s.Members {
+		if member.UserID == userID {
+			return slices.Contains(memberStatuses, member.Status)
+		}
+	}
+
+	return false
+}
+
+// Team returns the 1-based team number of userID, 0 when the user is in no team
+func (s *Session) Team(userID string) int {
+	for i, team := range s.Teams {
+		if slices.Contains(team, userID) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// ChannelID is the canonical channel ID of the session, <template>.<session ID>, or of its team when team is not 0,
+// <template>.<session ID>.team<team>. The kind of session replaces a missing template.
+func (s *Session) ChannelID(team int) string {
+	prefix := s.Template
+	if prefix == "" {
+		prefix = s.Kind
+	}
+	channelID := prefix + "." + s.ID
+	if team > 0 {
+		channelID += ".team" + strconv.Itoa(team)
+	}
+
+	return channelID
+}
+
+// parseSessionChannel splits a channel ID into its session ID, template and team number. Bare session IDs are
+// returned with an empty template.
+func parseSessionChannel(channelID string) (sessionID, template string, team int) {
+	parts := strings.Split(channelID, ".")
+	if last := parts[len(parts)-1]; len(parts) > 2 && strings.HasPrefix(last, "team") {
+		if number, err := strconv.Atoi(strings.TrimPrefix(last, "team")); err == nil && number > 0 {
+			team, parts = number, parts[:len(parts)-1]
+		}
+	}
+	sessionID = parts[len(parts)-1]
+	template = strings.Join(parts[:len(parts)-1], ".")
+
+	return sessionID, template, team
+}
+
+// ErrSessionNotFound is returned when no game session or party has the requested ID
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionLookup returns AccelByte sessions by ID
+type SessionLookup interface {
+	LookupSession(ctx context.Context, namespace, sessionID string) (*Session, error)
+}
+
+// SessionServiceLookup reads game sessions and parties from the AccelByte Session service
+type SessionServiceLookup struct {
+	gameSessions *session.GameSessionService
+	parties      *session.PartyService
+}
+
+// NewSessionServiceLookup returns a lookup querying the Session service with the token of the app's client
+func NewSessionServiceLookup(tokenRepo repository.TokenRepository, configRepo repository.ConfigRepository) *SessionServiceLookup {
+	client := factory.NewSessionClient(configRepo)
+
+	return &SessionServiceLookup{
+		gameSessions: &session.GameSessionService{Client: client, ConfigRepository: configRepo, TokenRepository: tokenRepo},
+		parties:      &session.PartyService{Client: client, ConfigRepository: configRepo, TokenRepository: tokenRepo},
+	}
+}
+
+// LookupSession returns the game session sessionID, or the party when no game session has this ID
+func (l *SessionServiceLookup) LookupSession(ctx context.Context, namespace, sessionID string) (*Session, error) {
+	gameSession, err := l.gameSessions.GetGameSessionShort(&game_session.GetGameSessionParams{
+		Namespace: namespace,
+		SessionID: sessionID,
+		Context:   ctx,
+	})
+	var gameNotFound *game_session.GetGameSessionNotFound
+	if err == nil && gameSession != nil {
+		s := &Session{
+			ID:       sessionID,
+			Kind:     SessionKindGame,
+			Template: configurationName(gameSession.Configuration),
+			LeaderID: stringValue(gameSession.LeaderID),
+			Members:  sessionMembers(gameSession.Members),
+		}
+		for _, team := range gameSession.Teams {
+			if team != nil {
+				s.Teams = append(s.Teams, team.UserIDs)
+			}
+		}
+
+		return s, nil
+	} else if err != nil && !errors.As(err, &gameNotFound) {
+		return nil, errors.Wrap(err, "get game session")
+	}
+
+	partySession, err := l.parties.PublicGetPartyShort(&party.PublicGetPartyParams{
+		Namespace: namespace,
+		PartyID:   sessionID,
+		Context:   ctx,
+	})
+	var partyNotFound *party.PublicGetPartyNotFound
+	if errors.As(err, &partyNotFound) || (err == nil && partySession == nil) {
+		return nil, errors.Wrapf(ErrSessionNotFound, "session %s of namespace %s", sessionID, namespace)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get party")
+	}
+
+	return &Session{
+		ID:       sessionID,
+		Kind:     SessionKindParty,
+		Template: configurationName(partySession.Configuration),
+		LeaderID: stringValue(partySession.LeaderID),
+		Members:  sessionMembers(partySession.Members),
+	}, nil
+}
+
+func configurationName(configuration *sessionclientmodels.ApimodelsPublicConfiguration) string {
+	if configuration == nil {
+		return ""
+	}
+
+	return stringValue(configuration.Name)
+}
+
+func sessionMembers(users []*sessionclientmodels.ApimodelsUserResponse) []SessionMember {
+	members := make([]SessionMember, 0, len(users))
+	for _, user := range users {
+		if user == nil {
+			continue
+		}
+		member := SessionMember{UserID: stringValue(user.ID), Status: stringValue(user.StatusV2)}
+		if member.Status == "" {
+			member.Status = stringValue(user.Status)
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// sessionChannelType is the channel type of the channel of s, or of its team when team is not 0. Team channels, and
+// sessions whose template and kind have no configured type, are nonpositional.
+func sessionChannelType(config utils.VivoxConfig, s *Session, team int) pb.GenerateVivoxTokenRequestChannelType {
+	channelType := pb.GenerateVivoxTokenRequestChannelType(pb.GenerateVivoxTokenRequestChannelType_value[config.SessionChannelType(s.Template, s.Kind)])
+	if team > 0 || channelType == pb.GenerateVivoxTokenRequestChannelType_generatevivoxtokenrequest_channeltype_unknown {
+		return pb.GenerateVivoxTokenRequestChannelType_nonpositional
+	}
+
+	return channelType
+}
+
+// SessionChannelAuthorizer treats channel IDs as the channels of AccelByte game sessions or parties, in the canonical
+// form of Session.ChannelID and of the channel type of the session, so that a session has a single Vivox channel and
+// one per team. Bare session IDs are denied. Users may join, join muted and transcribe the channel of a session they
+// are a current member of, or of a team they are in. Mute and kick silence another member for the whole channel, so
+// only the leader of the session may request them.
+type SessionChannelAuthorizer struct {
+	sessions SessionLookup
+	config   utils.VivoxConfig
+}
+
+// NewSessionChannelAuthorizer returns an authorizer checking the channels against the sessions of lookup and the
+// session channel types of config
+func NewSessionChannelAuthorizer(sessions SessionLookup, config utils.VivoxConfig) *SessionChannelAuthorizer {
+	return &SessionChannelAuthorizer{sessions: sessions, config: config}
+}
+
+func (a *SessionChannelAuthorizer) AuthorizeChannel(
+	ctx context.Context, namespace, userID, targetUserID, channelID string,
+	channelType pb.GenerateVivoxTokenRequestChannelType, action pb.GenerateVivoxTokenRequestType,
+) error {
+	if channelID == "" {
+		return errors.Wrap(ErrChannelAccessDenied, "tokens without channel are not issued for sessions")
+	}
+
+	sessionID, template, team := parseSessionChannel(channelID)
+	s, err := a.sessions.LookupSession(ctx, namespace, sessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return errors.Wrapf(ErrChannelAccessDenied, "channel %s is not a session of namespace %s", channelID, namespace)
+	} else if err != nil {
+		return err
+	}
+
+	if template == "" || channelID != s.ChannelID(team) {
+		return errors.Wrapf(ErrChannelAccessDenied, "channel %s is not a channel of session %s", channelID, sessionID)
+	}
+	if want := sessionChannelType(a.config, s, team); channelType != want {
+		return errors.Wrapf(ErrChannelAccessDenied, "channel %s is %s, not %s", channelID, want, channelType)
+	}
+	users := []string{userID}
+	if action == pb.GenerateVivoxTokenRequestType_kick || action == pb.GenerateVivoxTokenRequestType_mute {
+		users = append(users, targetUserID)
+	}
+	for _, user := range users {
+		if !s.IsCurrentMember(user) {
+			return errors.Wrapf(ErrChannelAccessDenied, "user %s is not a member of session %s", user, sessionID)
+		}
+		if team > 0 && s.Team(user) != team {
+			return errors.Wrapf(ErrChannelAccessDenied, "user %s is not in team %d of session %s", user, team, sessionID)
+		}
+	}
+	if (action == pb.GenerateVivoxTokenRequestType_kick || action == pb.GenerateVivoxTokenRequestType_mute) && s.LeaderID != userID {
+		return errors.Wrapf(ErrChannelAccessDenied, "user %s is not the leader of session %s", userID, sessionID)
+	}
+
+	return nil
+}
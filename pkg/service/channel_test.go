@@ -0,0 +1,455 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"extend-rtu-vivox-authorization-service/pkg/common"
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"extend-rtu-vivox-authorization-service/pkg/service/mocks"
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/go-jose/jwt"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testGameSession = `{"id":"match1","namespace":"accelbyte","leaderID":"host","configuration":{"name":"deathmatch"},"members":[
+		{"id":"host","status":"JOINED","statusV2":"CONNECTED"},
+		{"id":"player","status":"JOINED","statusV2":"JOINED"},
+		{"id":"quitter","status":"LEFT","statusV2":"LEFT"},
+		{"id":"invitee","status":"INVITED","statusV2":"INVITED"},
+		{"id":"rookie","status":"JOINED","statusV2":"CONNECTED"}],
+		"teams":[{"teamID":"red","userIDs":["host","quitter","rookie"]},{"teamID":"blue","userIDs":["player"]}]}`
+	testParty = `{"id":"party1","namespace":"accelbyte","leaderID":"player","configuration":{"name":"squad"},"members":[
+		{"id":"player","status":"JOINED","statusV2":"CONNECTED"},
+		{"id":"friend","status":"JOINED","statusV2":"JOINED"}]}`
+)
+
+// newSessionStandIn serves the game session and party endpoints of the Session service, other IDs are not found
+func newSessionStandIn(t *testing.T, sessions map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer service-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if body, found := sessions[r.URL.Path]; found {
+			if body == "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"errorCode":20000,"errorMessage":"internal server error"}`))
+
+				return
+			}
+			_, _ = w.Write([]byte(body))
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errorCode":20041,"errorMessage":"session not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestSessionServiceLookup(t *testing.T, ctrl *gomock.Controller) *SessionServiceLookup {
+	server := newSessionStandIn(t, map[string]string{
+		"/session/v1/public/namespaces/accelbyte/gamesessions/match1": testGameSession,
+		"/session/v1/public/namespaces/accelbyte/parties/party1":      testParty,
+		"/session/v1/public/namespaces/accelbyte/gamesessions/broken": "",
+	})
+
+	accessToken := "service-token"
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	tokenRepo.EXPECT().GetToken().Return(&iamclientmodels.OauthmodelTokenResponseV3{AccessToken: &accessToken}, nil).AnyTimes()
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	configRepo.EXPECT().GetJusticeBaseUrl().Return(server.URL).AnyTimes()
+
+	return NewSessionServiceLookup(tokenRepo, configRepo)
+}
+
+func TestSessionServiceLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	lookup := newTestSessionServiceLookup(t, ctrl)
+
+	s, err := lookup.LookupSession(context.Background(), "accelbyte", "match1")
+	require.NoError(t, err)
+	assert.Equal(t, SessionKindGame, s.Kind)
+	assert.Equal(t, "deathmatch", s.Template)
+	assert.Equal(t, "host", s.LeaderID)
+	assert.Equal(t, [][]string{{"host", "quitter", "rookie"}, {"player"}}, s.Teams)
+	assert.Equal(t, SessionMember{UserID: "host", Status: "CONNECTED"}, s.Members[0])
+	assert.Equal(t, 2, s.Team("player"))
+	assert.Equal(t, 0, s.Team("invitee"))
+	assert.Equal(t, "deathmatch.match1", s.ChannelID(0))
+	assert.Equal(t, "deathmatch.match1.team2", s.ChannelID(2))
+
+	s, err = lookup.LookupSession(context.Background(), "accelbyte", "party1")
+	require.NoError(t, err)
+	assert.Equal(t, SessionKindParty, s.Kind)
+	assert.Equal(t, "squad", s.Template)
+	assert.Empty(t, s.Teams)
+	s.Template = ""
+	assert.Equal(t, "party.party1", s.ChannelID(0), "the kind stands in for a missing template")
+
+	_, err = lookup.LookupSession(context.Background(), "accelbyte", "lobby")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestSessionChannelAuthorizer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	authorizer := NewSessionChannelAuthorizer(newTestSessionServiceLookup(t, ctrl), testConfig().Vivox)
+
+	// game sessions are positional by default, parties and teams nonpositional
+	positional := pb.GenerateVivoxTokenRequestChannelType_positional
+	tests := []struct {
+		name      string
+		userID    string
+		targetID  string
+		channelID string
+		// nonpositional unless set
+		channelType pb.GenerateVivoxTokenRequestChannelType
+		action      pb.GenerateVivoxTokenRequestType
+		wantErr     string
+		denied      bool
+	}{
+		{name: "team member joins the session channel", userID: "player", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_join},
+		{name: "connected member", userID: "host", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_join_muted},
+		{name: "party member", userID: "player", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_join},
+		{name: "member transcribes", userID: "player", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_transcription},
+		{name: "leader mutes", userID: "host", targetID: "player", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_mute},
+		{name: "party leader mutes", userID: "player", targetID: "friend", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_mute},
+		{name: "leader kicks", userID: "host", targetID: "player", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_kick},
+		{name: "party leader kicks", userID: "player", targetID: "friend", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_kick},
+		{name: "team channel", userID: "player", channelID: "deathmatch.match1.team2", action: pb.GenerateVivoxTokenRequestType_join},
+		{name: "team leader kicks", userID: "host", targetID: "rookie", channelID: "deathmatch.match1.team1", action: pb.GenerateVivoxTokenRequestType_kick},
+		{
+			name: "other team", userID: "player", channelID: "deathmatch.match1.team1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "user player is not in team 1 of session match1", denied: true,
+		},
+		{
+			name: "other template", userID: "player", channelID: "squad.match1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "channel squad.match1 is not a channel of session match1", denied: true,
+		},
+		{
+			name: "former team member", userID: "quitter", channelID: "deathmatch.match1.team1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "user quitter is not a member of session match1", denied: true,
+		},
+		{
+			name: "member kicks", userID: "player", targetID: "host", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_kick,
+			wantErr: "user player is not the leader of session match1", denied: true,
+		},
+		{
+			name: "leader kicks former member", userID: "host", targetID: "quitter", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_kick,
+			wantErr: "user quitter is not a member of session match1", denied: true,
+		},
+		{
+			name: "leader kicks outside the team", userID: "host", targetID: "player", channelID: "deathmatch.match1.team1",
+			action: pb.GenerateVivoxTokenRequestType_kick, wantErr: "user player is not in team 1 of session match1", denied: true,
+		},
+		{
+			name: "member mutes", userID: "player", targetID: "host", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_mute,
+			wantErr: "user player is not the leader of session match1", denied: true,
+		},
+		{
+			name: "party member mutes", userID: "friend", targetID: "player", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_mute,
+			wantErr: "user friend is not the leader of session party1", denied: true,
+		},
+		{
+			name: "leader mutes outsider", userID: "host", targetID: "eavesdropper", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_mute,
+			wantErr: "user eavesdropper is not a member of session match1", denied: true,
+		},
+		{
+			name: "outsider mutes", userID: "eavesdropper", targetID: "player", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_mute,
+			wantErr: "user eavesdropper is not a member of session party1", denied: true,
+		},
+		{
+			name: "outsider transcribes", userID: "eavesdropper", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_transcription,
+			wantErr: "user eavesdropper is not a member of session party1", denied: true,
+		},
+		{
+			name: "not a member", userID: "eavesdropper", channelID: "squad.party1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "user eavesdropper is not a member of session party1", denied: true,
+		},
+		{
+			name: "former member", userID: "quitter", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "user quitter is not a member of session match1", denied: true,
+		},
+		{
+			name: "invited member", userID: "invitee", channelID: "deathmatch.match1", channelType: positional, action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "user invitee is not a member of session match1", denied: true,
+		},
+		{
+			name: "bare team channel", userID: "host", channelID: "match1.team1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "channel match1.team1 is not a session of namespace accelbyte", denied: true,
+		},
+		{
+			name: "bare session ID", userID: "player", channelID: "match1", channelType: positional,
+			action: pb.GenerateVivoxTokenRequestType_join, wantErr: "channel match1 is not a channel of session match1", denied: true,
+		},
+		{
+			name: "bare party ID", userID: "player", channelID: "party1", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "channel party1 is not a channel of session party1", denied: true,
+		},
+		{
+			name: "party channel of another type", userID: "player", channelID: "squad.party1", channelType: positional,
+			action: pb.GenerateVivoxTokenRequestType_join, wantErr: "channel squad.party1 is nonpositional, not positional", denied: true,
+		},
+		{
+			name: "game channel as echo", userID: "player", channelID: "deathmatch.match1", channelType: pb.GenerateVivoxTokenRequestChannelType_echo,
+			action: pb.GenerateVivoxTokenRequestType_join_muted, wantErr: "channel deathmatch.match1 is positional, not echo", denied: true,
+		},
+		{
+			name: "team channel of another type", userID: "player", channelID: "deathmatch.match1.team2", channelType: positional,
+			action: pb.GenerateVivoxTokenRequestType_join, wantErr: "channel deathmatch.match1.team2 is nonpositional, not positional", denied: true,
+		},
+		{
+			name: "unknown channel", userID: "player", channelID: "lobby", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "channel lobby is not a session of namespace accelbyte", denied: true,
+		},
+		{
+			name: "server kick", userID: "host", targetID: "player", channelID: "", action: pb.GenerateVivoxTokenRequestType_kick,
+			wantErr: "tokens without channel are not issued for sessions", denied: true,
+		},
+		{
+			name: "session service error", userID: "player", channelID: "broken", action: pb.GenerateVivoxTokenRequestType_join,
+			wantErr: "get game session",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channelType := tt.channelType
+			if channelType == pb.GenerateVivoxTokenRequestChannelType_generatevivoxtokenrequest_channeltype_unknown {
+				channelType = pb.GenerateVivoxTokenRequestChannelType_nonpositional
+			}
+			err := authorizer.AuthorizeChannel(context.Background(), "accelbyte", tt.userID, tt.targetID, tt.channelID, channelType, tt.action)
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+			assert.Equal(t, tt.denied, errors.Is(err, ErrChannelAccessDenied))
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokenChannelAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+
+	var checked []string
+	authorizer := ChannelAuthorizerFunc(func(
+		_ context.Context, namespace, userID, targetUserID, channelID string,
+		_ pb.GenerateVivoxTokenRequestChannelType, action pb.GenerateVivoxTokenRequestType,
+	) error {
+		checked = append(checked, action.String())
+		if channelID == "private" {
+			return errors.Wrapf(ErrChannelAccessDenied, "user %s is not a member of session %s", userID, channelID)
+		}
+		if targetUserID == "outsider" {
+			return errors.Wrapf(ErrChannelAccessDenied, "user %s is not a member of session %s", targetUserID, channelID)
+		}
+		if channelID == "unreachable" {
+			return context.DeadlineExceeded
+		}
+
+		return nil
+	})
+	config := testConfig()
+	config.Vivox.TranscriptionNamespaces = []string{"*"}
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil,
+		WithChannelAuthorizer(authorizer), WithSerialSource(vivox.NewMonotonicSerialSource(0)))
+	denied := common.AuthFailures.WithLabelValues(common.AuthFailureChannelAccessDenied)
+	before := testutil.ToFloat64(denied)
+
+	_, err := service.GenerateVivoxToken(context.Background(), joinRequest("player", pb.GenerateVivoxTokenRequestType_join))
+	require.NoError(t, err)
+
+	req := joinRequest("player", pb.GenerateVivoxTokenRequestType_join_muted)
+	req.ChannelId = "private"
+	_, err = service.GenerateVivoxToken(context.Background(), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, "user player is not a member of session private: channel access denied", status.Convert(err).Message())
+	require.Equal(t, before+1, testutil.ToFloat64(denied))
+
+	req.ChannelId = "unreachable"
+	_, err = service.GenerateVivoxToken(context.Background(), req)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_kick, Username: "host", TargetUsername: "griefer", ChannelId: "lobby",
+	})
+	require.NoError(t, err)
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_kick, Username: "host", TargetUsername: "outsider", ChannelId: "lobby",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "the target is checked too")
+
+	// mute and transcription carry a channel as well
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_mute, Username: "host", TargetUsername: "griefer", ChannelId: "private",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	req = joinRequest("player", pb.GenerateVivoxTokenRequestType_transcription)
+	req.ChannelId = "private"
+	_, err = service.GenerateVivoxToken(context.Background(), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, before+4, testutil.ToFloat64(denied))
+
+	// login tokens have no channel, malformed IDs are rejected before the authorizer is asked
+	res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "player"})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Serial, "denied tokens are not signed")
+	req = joinRequest("player", pb.GenerateVivoxTokenRequestType_join)
+	req.ChannelId = "lobby 1"
+	_, err = service.GenerateVivoxToken(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.Equal(t, []string{"join", "join_muted", "join_muted", "kick", "kick", "mute", "transcription"}, checked)
+}
+
+// sessionMap is a SessionLookup of fixed sessions, other IDs are not found
+type sessionMap map[string]*Session
+
+func (m sessionMap) LookupSession(_ context.Context, namespace, sessionID string) (*Session, error) {
+	if sessionID == "broken" {
+		return nil, context.DeadlineExceeded
+	}
+	if s, found := m[sessionID]; found {
+		return s, nil
+	}
+
+	return nil, errors.Wrapf(ErrSessionNotFound, "session %s of namespace %s", sessionID, namespace)
+}
+
+// countedSessions counts the lookups made through it
+type countedSessions struct {
+	SessionLookup
+	lookups int
+}
+
+func (c *countedSessions) LookupSession(ctx context.Context, namespace, sessionID string) (*Session, error) {
+	c.lookups++
+
+	return c.SessionLookup.LookupSession(ctx, namespace, sessionID)
+}
+
+func TestMyServiceServerImpl_JoinSessionVoice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+
+	sessions := sessionMap{
+		"match1": {
+			ID: "match1", Kind: SessionKindGame, Template: "deathmatch", LeaderID: "host",
+			Members: []SessionMember{{UserID: "host", Status: "CONNECTED"}, {UserID: "player", Status: "JOINED"}, {UserID: "solo", Status: "JOINED"}},
+			Teams:   [][]string{{"host"}, {"player"}},
+		},
+		"party1": {ID: "party1", Kind: SessionKindParty, LeaderID: "player", Members: []SessionMember{{UserID: "player", Status: "JOINED"}}},
+	}
+	config := testConfig()
+	config.Vivox.SessionChannelTypes["deathmatch"] = pb.GenerateVivoxTokenRequestChannelType_echo.String()
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil, WithSessionLookup(sessions))
+
+	res, err := service.JoinSessionVoice(context.Background(), &pb.JoinSessionVoiceRequest{SessionId: "match1", Username: "player", TeamChannel: true})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JoinSessionVoiceSessionType_game_session, res.SessionType)
+	assert.Equal(t, "deathmatch", res.Template)
+	assert.Equal(t, pb.GenerateVivoxTokenRequestChannelType_echo, res.ChannelType, "the template overrides the kind")
+	assert.Equal(t, "deathmatch.match1", res.ChannelId)
+	assert.Equal(t, "login", res.Login.Action)
+	assert.Equal(t, "join", res.Join.Action)
+	assert.Equal(t, "sip:confctl-e-"+testIssuer+".deathmatch.match1@"+testDomain, res.Join.Uri)
+	assert.Equal(t, "deathmatch.match1.team2", res.TeamChannelId)
+	assert.Equal(t, "sip:confctl-g-"+testIssuer+".deathmatch.match1.team2@"+testDomain, res.TeamJoin.Uri)
+
+	res, err = service.JoinSessionVoice(context.Background(), &pb.JoinSessionVoiceRequest{SessionId: "match1", Username: "solo", TeamChannel: true})
+	require.NoError(t, err)
+	assert.Empty(t, res.TeamChannelId, "users without team get no team channel")
+	assert.Nil(t, res.TeamJoin)
+
+	// the caller's own user ID is the default username
+	ctx := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{Token: "token", Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "player"}}})
+	res, err = service.JoinSessionVoice(ctx, &pb.JoinSessionVoiceRequest{SessionId: "party1"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.JoinSessionVoiceSessionType_party_session, res.SessionType)
+	assert.Equal(t, pb.GenerateVivoxTokenRequestChannelType_nonpositional, res.ChannelType)
+	assert.Equal(t, "party.party1", res.ChannelId)
+	assert.Equal(t, "sip:confctl-g-"+testIssuer+".party.party1@"+testDomain, res.Join.Uri)
+
+	denied := common.AuthFailures.WithLabelValues(common.AuthFailureChannelAccessDenied)
+	before := testutil.ToFloat64(denied)
+	tests := []struct {
+		name     string
+		req      *pb.JoinSessionVoiceRequest
+		wantCode codes.Code
+	}{
+		{name: "not a member", req: &pb.JoinSessionVoiceRequest{SessionId: "party1", Username: "host"}, wantCode: codes.PermissionDenied},
+		{name: "unknown session", req: &pb.JoinSessionVoiceRequest{SessionId: "lobby", Username: "player"}, wantCode: codes.NotFound},
+		{name: "session service error", req: &pb.JoinSessionVoiceRequest{SessionId: "broken", Username: "player"}, wantCode: codes.Unavailable},
+		{name: "missing session", req: &pb.JoinSessionVoiceRequest{Username: "player"}, wantCode: codes.InvalidArgument},
+		{name: "missing username", req: &pb.JoinSessionVoiceRequest{SessionId: "party1"}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.JoinSessionVoice(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err), err)
+		})
+	}
+	assert.Equal(t, before+1, testutil.ToFloat64(denied))
+
+	// the channels of the session are not authorized again
+	counted := &countedSessions{SessionLookup: sessions}
+	service = NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil,
+		WithSessionLookup(counted), WithChannelAuthorizer(NewSessionChannelAuthorizer(counted, config.Vivox)))
+	res, err = service.JoinSessionVoice(context.Background(), &pb.JoinSessionVoiceRequest{SessionId: "match1", Username: "player", TeamChannel: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, counted.lookups)
+
+	// which the authorizer would accept, with their channel type
+	authorizer := NewSessionChannelAuthorizer(sessions, config.Vivox)
+	join := pb.GenerateVivoxTokenRequestType_join
+	require.NoError(t, authorizer.AuthorizeChannel(context.Background(), "accelbyte", "player", "", res.ChannelId, res.ChannelType, join))
+	require.NoError(t, authorizer.AuthorizeChannel(
+		context.Background(), "accelbyte", "player", "", res.TeamChannelId, pb.GenerateVivoxTokenRequestChannelType_nonpositional, join,
+	))
+
+	// callers without the admin permission cannot probe the sessions of other users
+	original := common.Validator
+	common.Validator = &fakeValidator{}
+	defer func() { common.Validator = original }()
+	_, err = service.JoinSessionVoice(ctx, &pb.JoinSessionVoiceRequest{SessionId: "match1", Username: "host"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, "username host does not match the authenticated user", status.Convert(err).Message())
+	assert.Equal(t, 1, counted.lookups, "the session is not looked up")
+
+	_, err = NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil).
+		JoinSessionVoice(context.Background(), &pb.JoinSessionVoiceRequest{SessionId: "party1", Username: "player"})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	utils "extend-rtu-vivox-authorization-service/pkg/common"
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient/users"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/pkg/errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkIAMBans rejects the tokens of a user whose active AccelByte IAM bans deny the requested action.
+// The bans are read from the caller's access token when the caller is the user, and from IAM when the lookup is enabled.
+// Without the lookup, the bans of a user whose token is requested on behalf of them are not checked.
+func (g MyServiceServerImpl) checkIAMBans(ctx context.Context, namespace string, req *pb.GenerateVivoxTokenRequest) error {
+	if !g.config.Vivox.IAMBansEnabled {
+		return nil
+	}
+
+	var bans []iam.JWTBan
+	if authInfo, found := utils.AuthInfoFromContext(ctx); found && authInfo.UserID() == req.Username {
+		bans = append(bans, authInfo.Claims.Bans...)
+	}
+	if g.iamUsers != nil {
+		current, err := g.lookupIAMBans(ctx, namespace, req.Username)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "error look up IAM bans of user %s: %v", req.Username, err)
+		}
+		bans = append(bans, current...)
+	}
+
+	now := time.Now()
+	action := req.Type.String()
+	for _, ban := range bans {
+		denied := g.config.Vivox.IAMBanActions[ban.Ban]
+		if (!ban.EndDate.IsZero() && !now.Before(ban.EndDate)) ||
+			!(slices.Contains(denied, utils.IAMBanAllActions) || slices.Contains(denied, action)) {
+			continue
+		}
+		utils.AuthFailures.WithLabelValues(utils.AuthFailureIAMBanned).Inc()
+
+		return iamBannedStatus(req.Username, action, ban)
+	}
+
+	return nil
+}
+
+// lookupIAMBans returns the active bans of userID in IAM. Usernames that are not IAM users have no bans.
+func (g MyServiceServerImpl) lookupIAMBans(ctx context.Context, namespace, userID string) ([]iam.JWTBan, error) {
+	activeOnly := true
+	res, err := g.iamUsers.AdminGetUserBanV3Short(&users.AdminGetUserBanV3Params{
+		Namespace:  namespace,
+		UserID:     userID,
+		ActiveOnly: &activeOnly,
+		Context:    ctx,
+	})
+	var notFound *users.AdminGetUserBanV3NotFound
+	var badRequest *users.AdminGetUserBanV3BadRequest
+	if errors.As(err, &notFound) || errors.As(err, &badRequest) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+
+	var bans []iam.JWTBan
+	for _, ban := range res.Data {
+		if ban == nil || ban.Ban == nil || (ban.Enabled != nil && !*ban.Enabled) {
+			continue
+		}
+		bans = append(bans, iam.JWTBan{Ban: *ban.Ban, EndDate: time.Time(ban.EndDate)})
+	}
+
+	return bans, nil
+}
+
+func iamBannedStatus(username, action string, ban iam.JWTBan) error {
+	message := "user " + username + " has an IAM " + ban.Ban + " ban denying " + action + " tokens"
+	metadata := map[string]string{"ban": ban.Ban}
+	if !ban.EndDate.IsZero() {
+		until := ban.EndDate.UTC().Format(time.RFC3339)
+		message += " until " + until
+		metadata["endDate"] = until
+	}
+
+	st := status.New(codes.PermissionDenied, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: "IAM_BANNED", Domain: "vivox", Metadata: metadata})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
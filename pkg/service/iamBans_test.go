@@ -0,0 +1,171 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/common"
+	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"extend-rtu-vivox-authorization-service/pkg/service/mocks"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/go-jose/jwt"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func joinRequest(username string, action pb.GenerateVivoxTokenRequestType) *pb.GenerateVivoxTokenRequest {
+	return &pb.GenerateVivoxTokenRequest{
+		Type: action, Username: username, ChannelId: "lobby",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	}
+}
+
+func TestMyServiceServerImpl_IAMBanClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+
+	endDate := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	ctx := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{
+		Token: "token",
+		Claims: iam.JWTClaims{
+			Claims: jwt.Claims{Subject: "chatty"},
+			Bans: []iam.JWTBan{
+				{Ban: "CHAT_SEND", EndDate: endDate},
+				{Ban: "CHAT_ALL", EndDate: time.Now().Add(-time.Minute)},
+				{Ban: "LEADERBOARD", EndDate: endDate},
+			},
+		},
+	})
+
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+	denied := common.AuthFailures.WithLabelValues(common.AuthFailureIAMBanned)
+	before := testutil.ToFloat64(denied)
+
+	_, err := service.GenerateVivoxToken(ctx, joinRequest("chatty", pb.GenerateVivoxTokenRequestType_join))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, "user chatty has an IAM CHAT_SEND ban denying join tokens until "+endDate.Format(time.RFC3339), status.Convert(err).Message())
+	errorInfo, ok := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "IAM_BANNED", errorInfo.Reason)
+	assert.Equal(t, "CHAT_SEND", errorInfo.Metadata["ban"])
+	assert.Equal(t, before+1, testutil.ToFloat64(denied))
+
+	// by default CHAT_SEND denies every action that speaks or silences others, listening is allowed,
+	// the expired ban and unmapped ban types are ignored
+	config := testConfig()
+	config.Vivox.TranscriptionNamespaces = []string{"*"}
+	service = NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+	for action, wantCode := range map[pb.GenerateVivoxTokenRequestType]codes.Code{
+		pb.GenerateVivoxTokenRequestType_login:         codes.OK,
+		pb.GenerateVivoxTokenRequestType_join:          codes.PermissionDenied,
+		pb.GenerateVivoxTokenRequestType_join_muted:    codes.OK,
+		pb.GenerateVivoxTokenRequestType_kick:          codes.PermissionDenied,
+		pb.GenerateVivoxTokenRequestType_mute:          codes.PermissionDenied,
+		pb.GenerateVivoxTokenRequestType_transcription: codes.PermissionDenied,
+	} {
+		req := joinRequest("chatty", action)
+		req.TargetUsername = "listener"
+		_, err = service.GenerateVivoxToken(ctx, req)
+		require.Equal(t, wantCode, status.Code(err), action.String())
+	}
+
+	config = testConfig()
+	config.Vivox.IAMBanActions = map[string][]string{"LEADERBOARD": {common.IAMBanAllActions}}
+	service = NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+	_, err = service.GenerateVivoxToken(ctx, &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "chatty"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "the mapping is configurable")
+
+	config.Vivox.IAMBansEnabled = false
+	service = NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+	_, err = service.GenerateVivoxToken(ctx, joinRequest("chatty", pb.GenerateVivoxTokenRequestType_join))
+	require.NoError(t, err)
+}
+
+// newIAMStandIn serves the user bans endpoint of IAM with the bans of each user, users missing from bans are not found
+func newIAMStandIn(t *testing.T, bans map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer service-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "true", r.URL.Query().Get("activeOnly"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/iam/v3/admin/namespaces/accelbyte/users/unavailable/bans":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errorCode":20000,"errorMessage":"internal server error"}`))
+		default:
+			for userID, body := range bans {
+				if r.URL.Path == "/iam/v3/admin/namespaces/accelbyte/users/"+userID+"/bans" {
+					_, _ = w.Write([]byte(body))
+
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorCode":20008,"errorMessage":"user not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestMyServiceServerImpl_IAMBanLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endDate := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	server := newIAMStandIn(t, map[string]string{
+		"banned": `{"data":[{"ban":"CHAT_ALL","banId":"b1","enabled":true,"endDate":"` + endDate.Format(time.RFC3339) + `","namespace":"accelbyte","userId":"banned"}],"paging":{}}`,
+		"lifted": `{"data":[{"ban":"CHAT_ALL","banId":"b2","enabled":false,"endDate":"` + endDate.Format(time.RFC3339) + `","namespace":"accelbyte","userId":"lifted"}],"paging":{}}`,
+		"player": `{"data":[],"paging":{}}`,
+	})
+
+	accessToken := "service-token"
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	tokenRepo.EXPECT().GetToken().Return(&iamclientmodels.OauthmodelTokenResponseV3{AccessToken: &accessToken}, nil).AnyTimes()
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	configRepo.EXPECT().GetJusticeBaseUrl().Return(server.URL).AnyTimes()
+
+	config := testConfig()
+	config.Vivox.IAMBansLookup = true
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+
+	tests := []struct {
+		username string
+		wantCode codes.Code
+	}{
+		{username: "banned", wantCode: codes.PermissionDenied},
+		{username: "lifted", wantCode: codes.OK},
+		{username: "player", wantCode: codes.OK},
+		{username: "not-an-iam-user", wantCode: codes.OK},
+		{username: "unavailable", wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			// a game server requesting tokens for the user, its own token carries no bans of the user
+			_, err := service.GenerateVivoxToken(context.Background(), joinRequest(tt.username, pb.GenerateVivoxTokenRequestType_join_muted))
+
+			require.Equal(t, tt.wantCode, status.Code(err), err)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// SigningKey is one version of a Vivox signing key
+type SigningKey struct {
+	Version string `yaml:"version"`
+	Key     string `yaml:"key"`
+	// previous keys are accepted for verification until RetireAt, which they must set
+	RetireAt *time.Time `yaml:"retireAt"`
+}
+
+// KeyVersion describes a loaded signing key without revealing it
+type KeyVersion struct {
+	Version  string
+	Active   bool
+	RetireAt *time.Time
+}
+
+// Keyring holds the versioned signing keys of a tenant, optionally reloaded from a file
+type Keyring struct {
+	path string
+
+	mu        sync.RWMutex
+	active    string
+	keys      []SigningKey
+	content   []byte
+	loadedAt  time.Time
+	reloadErr error
+}
+
+// NewStaticKeyring returns a keyring holding a single key that never changes
+func NewStaticKeyring(version, key string) *Keyring {
+	return &Keyring{
+		active:   version,
+		keys:     []SigningKey{{Version: version, Key: key}},
+		loadedAt: time.Now(),
+	}
+}
+
+// LoadKeyring reads a YAML file naming the active version and listing all key versions
+func LoadKeyring(path string) (*Keyring, error) {
+	k := &Keyring{path: path}
+	if _, err := k.Reload(); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// Reload re-reads the key file, returning whether the keys changed. The loaded keys are kept on error.
+func (k *Keyring) Reload() (bool, error) {
+	if k.path == "" {
+		return false, nil
+	}
+
+	content, err := os.ReadFile(k.path)
+	if err == nil {
+		k.mu.RLock()
+		unchanged := bytes.Equal(content, k.content)
+		k.mu.RUnlock()
+		if unchanged {
+			k.setReloadResult(nil)
+
+			return false, nil
+		}
+	}
+
+	var active string
+	var keys []SigningKey
+	if err == nil {
+		active, keys, err = parseKeyFile(content)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "load signing keys from %s", k.path)
+		k.setReloadResult(err)
+
+		return false, err
+	}
+
+	k.mu.Lock()
+	k.active = active
+	k.keys = keys
+	k.content = content
+	k.loadedAt = time.Now()
+	k.reloadErr = nil
+	k.mu.Unlock()
+
+	return true, nil
+}
+
+// Watch reloads the key file every period until ctx is done
+func (k *Keyring) Watch(ctx context.Context, period time.Duration) {
+	if k.path == "" || period <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := k.Reload()
+			if err != nil {
+				slog.Default().Error("error reloading vivox signing keys", "file", k.path, "error", err)
+			} else if changed {
+				slog.Default().Info("vivox signing keys reloaded", "file", k.path, "active", k.ActiveVersion())
+			}
+		}
+	}
+}
+
+// ActiveVersion returns the version of the key used for signing
+func (k *Keyring) ActiveVersion() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.active
+}
+
+// SigningKey returns the active key
+func (k *Keyring) SigningKey() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	for _, key := range k.keys {
+		if key.Version == k.active {
+			return key.Key
+		}
+	}
+
+	return ""
+}
+
+// VerificationKeys returns the active key followed by the previous keys not retired at now
+func (k *Keyring) VerificationKeys(now time.Time) []string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := make([]string, 0, len(k.keys))
+	for _, key := range k.keys {
+		if key.Version == k.active {
+			keys = append([]string{key.Key}, keys...)
+		} else if key.RetireAt != nil && now.Before(*key.RetireAt) {
+			keys = append(keys, key.Key)
+		}
+	}
+
+	return keys
+}
+
+// Versions lists the loaded key versions
+func (k *Keyring) Versions() []KeyVersion {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	versions := make([]KeyVersion, 0, len(k.keys))
+	for _, key := range k.keys {
+		versions = append(versions, KeyVersion{
+			Version:  key.Version,
+			Active:   key.Version == k.active,
+			RetireAt: key.RetireAt,
+		})
+	}
+
+	return versions
+}
+
+// LoadedAt returns when the current keys were loaded
+func (k *Keyring) LoadedAt() time.Time {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.loadedAt
+}
+
+// ReloadError returns the error of the last reload, if it failed
+func (k *Keyring) ReloadError() error {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.reloadErr
+}
+
+func (k *Keyring) setReloadResult(err error) {
+	k.mu.Lock()
+	k.reloadErr = err
+	k.mu.Unlock()
+}
+
+func parseKeyFile(content []byte) (string, []SigningKey, error) {
+	var file struct {
+		Active string       `yaml:"active"`
+		Keys   []SigningKey `yaml:"keys"`
+	}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return "", nil, err
+	}
+
+	seen := make(map[string]bool, len(file.Keys))
+	for i, key := range file.Keys {
+		if key.Version == "" || key.Key == "" {
+			return "", nil, fmt.Errorf("key %d: version and key are required", i)
+		}
+		if seen[key.Version] {
+			return "", nil, fmt.Errorf("key %s: version is listed more than once", key.Version)
+		}
+		seen[key.Version] = true
+	}
+	if !seen[file.Active] {
+		return "", nil, fmt.Errorf("active version %q is not listed in keys", file.Active)
+	}
+	// a forgotten retireAt would keep a leaked key valid forever
+	for _, key := range file.Keys {
+		if key.Version != file.Active && key.RetireAt == nil {
+			return "", nil, fmt.Errorf("key %s: retireAt is required for keys other than the active one", key.Version)
+		}
+	}
+
+	return file.Active, file.Keys, nil
+}
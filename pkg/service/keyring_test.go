@@ -0,0 +1,91 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestKeyringRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyFile(t, path, `
+active: v1
+keys:
+  - version: v1
+    key: first
+`)
+
+	keys, err := LoadKeyring(path)
+	require.NoError(t, err)
+	require.Equal(t, "first", keys.SigningKey())
+
+	// when
+	writeKeyFile(t, path, `
+active: v2
+keys:
+  - version: v2
+    key: second
+  - version: v1
+    key: first
+    retireAt: 2026-01-02T00:00:00Z
+`)
+	changed, err := keys.Reload()
+
+	// then
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "v2", keys.ActiveVersion())
+	require.Equal(t, "second", keys.SigningKey())
+	require.Equal(t, []string{"second", "first"}, keys.VerificationKeys(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, []string{"second"}, keys.VerificationKeys(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	changed, err = keys.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestKeyringInvalidReloadKeepsKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyFile(t, path, `
+active: v1
+keys:
+  - version: v1
+    key: first
+`)
+	keys, err := LoadKeyring(path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "unknown active version", content: "active: v2\nkeys:\n  - version: v1\n    key: first\n"},
+		{name: "duplicate version", content: "active: v1\nkeys:\n  - version: v1\n    key: a\n  - version: v1\n    key: b\n"},
+		{name: "missing key", content: "active: v1\nkeys:\n  - version: v1\n"},
+		{name: "previous key without retireAt", content: "active: v2\nkeys:\n  - version: v2\n    key: second\n  - version: v1\n    key: first\n"},
+		{name: "not yaml", content: "active: [v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeKeyFile(t, path, tt.content)
+
+			_, err := keys.Reload()
+
+			require.Error(t, err)
+			require.Error(t, keys.ReloadError())
+			require.Equal(t, "first", keys.SigningKey())
+		})
+	}
+}
@@ -36,6 +36,26 @@ func (m *MockServiceClient) EXPECT() *MockServiceClientMockRecorder {
 	return m.recorder
 }
 
+// CreateVivoxBan mocks base method.
+func (m *MockServiceClient) CreateVivoxBan(ctx context.Context, in *serviceextension.CreateVivoxBanRequest, opts ...grpc.CallOption) (*serviceextension.CreateVivoxBanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVivoxBan", varargs...)
+	ret0, _ := ret[0].(*serviceextension.CreateVivoxBanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVivoxBan indicates an expected call of CreateVivoxBan.
+func (mr *MockServiceClientMockRecorder) CreateVivoxBan(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVivoxBan", reflect.TypeOf((*MockServiceClient)(nil).CreateVivoxBan), varargs...)
+}
+
 // GenerateVivoxToken mocks base method.
 func (m *MockServiceClient) GenerateVivoxToken(ctx context.Context, in *serviceextension.GenerateVivoxTokenRequest, opts ...grpc.CallOption) (*serviceextension.GenerateVivoxTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +76,166 @@ func (mr *MockServiceClientMockRecorder) GenerateVivoxToken(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVivoxToken", reflect.TypeOf((*MockServiceClient)(nil).GenerateVivoxToken), varargs...)
 }
 
+// GenerateVivoxTokens mocks base method.
+func (m *MockServiceClient) GenerateVivoxTokens(ctx context.Context, in *serviceextension.GenerateVivoxTokensRequest, opts ...grpc.CallOption) (*serviceextension.GenerateVivoxTokensResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateVivoxTokens", varargs...)
+	ret0, _ := ret[0].(*serviceextension.GenerateVivoxTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateVivoxTokens indicates an expected call of GenerateVivoxTokens.
+func (mr *MockServiceClientMockRecorder) GenerateVivoxTokens(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVivoxTokens", reflect.TypeOf((*MockServiceClient)(nil).GenerateVivoxTokens), varargs...)
+}
+
+// JoinSessionVoice mocks base method.
+func (m *MockServiceClient) JoinSessionVoice(ctx context.Context, in *serviceextension.JoinSessionVoiceRequest, opts ...grpc.CallOption) (*serviceextension.JoinSessionVoiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinSessionVoice", varargs...)
+	ret0, _ := ret[0].(*serviceextension.JoinSessionVoiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinSessionVoice indicates an expected call of JoinSessionVoice.
+func (mr *MockServiceClientMockRecorder) JoinSessionVoice(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinSessionVoice", reflect.TypeOf((*MockServiceClient)(nil).JoinSessionVoice), varargs...)
+}
+
+// LiftVivoxBan mocks base method.
+func (m *MockServiceClient) LiftVivoxBan(ctx context.Context, in *serviceextension.LiftVivoxBanRequest, opts ...grpc.CallOption) (*serviceextension.LiftVivoxBanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LiftVivoxBan", varargs...)
+	ret0, _ := ret[0].(*serviceextension.LiftVivoxBanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiftVivoxBan indicates an expected call of LiftVivoxBan.
+func (mr *MockServiceClientMockRecorder) LiftVivoxBan(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiftVivoxBan", reflect.TypeOf((*MockServiceClient)(nil).LiftVivoxBan), varargs...)
+}
+
+// ListVivoxBans mocks base method.
+func (m *MockServiceClient) ListVivoxBans(ctx context.Context, in *serviceextension.ListVivoxBansRequest, opts ...grpc.CallOption) (*serviceextension.ListVivoxBansResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVivoxBans", varargs...)
+	ret0, _ := ret[0].(*serviceextension.ListVivoxBansResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVivoxBans indicates an expected call of ListVivoxBans.
+func (mr *MockServiceClientMockRecorder) ListVivoxBans(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVivoxBans", reflect.TypeOf((*MockServiceClient)(nil).ListVivoxBans), varargs...)
+}
+
+// ListVivoxSigningKeys mocks base method.
+func (m *MockServiceClient) ListVivoxSigningKeys(ctx context.Context, in *serviceextension.ListVivoxSigningKeysRequest, opts ...grpc.CallOption) (*serviceextension.ListVivoxSigningKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVivoxSigningKeys", varargs...)
+	ret0, _ := ret[0].(*serviceextension.ListVivoxSigningKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVivoxSigningKeys indicates an expected call of ListVivoxSigningKeys.
+func (mr *MockServiceClientMockRecorder) ListVivoxSigningKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVivoxSigningKeys", reflect.TypeOf((*MockServiceClient)(nil).ListVivoxSigningKeys), varargs...)
+}
+
+// RefreshVivoxToken mocks base method.
+func (m *MockServiceClient) RefreshVivoxToken(ctx context.Context, in *serviceextension.GenerateVivoxTokenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[serviceextension.GenerateVivoxTokenResponse], error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshVivoxToken", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[serviceextension.GenerateVivoxTokenResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshVivoxToken indicates an expected call of RefreshVivoxToken.
+func (mr *MockServiceClientMockRecorder) RefreshVivoxToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshVivoxToken", reflect.TypeOf((*MockServiceClient)(nil).RefreshVivoxToken), varargs...)
+}
+
+// ResolveVivoxUri mocks base method.
+func (m *MockServiceClient) ResolveVivoxUri(ctx context.Context, in *serviceextension.ResolveVivoxUriRequest, opts ...grpc.CallOption) (*serviceextension.ResolveVivoxUriResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveVivoxUri", varargs...)
+	ret0, _ := ret[0].(*serviceextension.ResolveVivoxUriResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVivoxUri indicates an expected call of ResolveVivoxUri.
+func (mr *MockServiceClientMockRecorder) ResolveVivoxUri(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVivoxUri", reflect.TypeOf((*MockServiceClient)(nil).ResolveVivoxUri), varargs...)
+}
+
+// VerifyVivoxToken mocks base method.
+func (m *MockServiceClient) VerifyVivoxToken(ctx context.Context, in *serviceextension.VerifyVivoxTokenRequest, opts ...grpc.CallOption) (*serviceextension.VerifyVivoxTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyVivoxToken", varargs...)
+	ret0, _ := ret[0].(*serviceextension.VerifyVivoxTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyVivoxToken indicates an expected call of VerifyVivoxToken.
+func (mr *MockServiceClientMockRecorder) VerifyVivoxToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyVivoxToken", reflect.TypeOf((*MockServiceClient)(nil).VerifyVivoxToken), varargs...)
+}
+
 // MockServiceServer is a mock of ServiceServer interface.
 type MockServiceServer struct {
 	ctrl     *gomock.Controller
@@ -79,6 +259,21 @@ func (m *MockServiceServer) EXPECT() *MockServiceServerMockRecorder {
 	return m.recorder
 }
 
+// CreateVivoxBan mocks base method.
+func (m *MockServiceServer) CreateVivoxBan(arg0 context.Context, arg1 *serviceextension.CreateVivoxBanRequest) (*serviceextension.CreateVivoxBanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVivoxBan", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.CreateVivoxBanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVivoxBan indicates an expected call of CreateVivoxBan.
+func (mr *MockServiceServerMockRecorder) CreateVivoxBan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVivoxBan", reflect.TypeOf((*MockServiceServer)(nil).CreateVivoxBan), arg0, arg1)
+}
+
 // GenerateVivoxToken mocks base method.
 func (m *MockServiceServer) GenerateVivoxToken(arg0 context.Context, arg1 *serviceextension.GenerateVivoxTokenRequest) (*serviceextension.GenerateVivoxTokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,16 +289,123 @@ func (mr *MockServiceServerMockRecorder) GenerateVivoxToken(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVivoxToken", reflect.TypeOf((*MockServiceServer)(nil).GenerateVivoxToken), arg0, arg1)
 }
 
-// mustEmbedUnimplementedServiceServer mocks base method.
-func (m *MockServiceServer) mustEmbedUnimplementedServiceServer() {
+// GenerateVivoxTokens mocks base method.
+func (m *MockServiceServer) GenerateVivoxTokens(arg0 context.Context, arg1 *serviceextension.GenerateVivoxTokensRequest) (*serviceextension.GenerateVivoxTokensResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedServiceServer")
+	ret := m.ctrl.Call(m, "GenerateVivoxTokens", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.GenerateVivoxTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedServiceServer indicates an expected call of mustEmbedUnimplementedServiceServer.
-func (mr *MockServiceServerMockRecorder) mustEmbedUnimplementedServiceServer() *gomock.Call {
+// GenerateVivoxTokens indicates an expected call of GenerateVivoxTokens.
+func (mr *MockServiceServerMockRecorder) GenerateVivoxTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVivoxTokens", reflect.TypeOf((*MockServiceServer)(nil).GenerateVivoxTokens), arg0, arg1)
+}
+
+// JoinSessionVoice mocks base method.
+func (m *MockServiceServer) JoinSessionVoice(arg0 context.Context, arg1 *serviceextension.JoinSessionVoiceRequest) (*serviceextension.JoinSessionVoiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinSessionVoice", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.JoinSessionVoiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinSessionVoice indicates an expected call of JoinSessionVoice.
+func (mr *MockServiceServerMockRecorder) JoinSessionVoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinSessionVoice", reflect.TypeOf((*MockServiceServer)(nil).JoinSessionVoice), arg0, arg1)
+}
+
+// LiftVivoxBan mocks base method.
+func (m *MockServiceServer) LiftVivoxBan(arg0 context.Context, arg1 *serviceextension.LiftVivoxBanRequest) (*serviceextension.LiftVivoxBanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiftVivoxBan", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.LiftVivoxBanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiftVivoxBan indicates an expected call of LiftVivoxBan.
+func (mr *MockServiceServerMockRecorder) LiftVivoxBan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiftVivoxBan", reflect.TypeOf((*MockServiceServer)(nil).LiftVivoxBan), arg0, arg1)
+}
+
+// ListVivoxBans mocks base method.
+func (m *MockServiceServer) ListVivoxBans(arg0 context.Context, arg1 *serviceextension.ListVivoxBansRequest) (*serviceextension.ListVivoxBansResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVivoxBans", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.ListVivoxBansResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVivoxBans indicates an expected call of ListVivoxBans.
+func (mr *MockServiceServerMockRecorder) ListVivoxBans(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVivoxBans", reflect.TypeOf((*MockServiceServer)(nil).ListVivoxBans), arg0, arg1)
+}
+
+// ListVivoxSigningKeys mocks base method.
+func (m *MockServiceServer) ListVivoxSigningKeys(arg0 context.Context, arg1 *serviceextension.ListVivoxSigningKeysRequest) (*serviceextension.ListVivoxSigningKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVivoxSigningKeys", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.ListVivoxSigningKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVivoxSigningKeys indicates an expected call of ListVivoxSigningKeys.
+func (mr *MockServiceServerMockRecorder) ListVivoxSigningKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVivoxSigningKeys", reflect.TypeOf((*MockServiceServer)(nil).ListVivoxSigningKeys), arg0, arg1)
+}
+
+// RefreshVivoxToken mocks base method.
+func (m *MockServiceServer) RefreshVivoxToken(arg0 *serviceextension.GenerateVivoxTokenRequest, arg1 grpc.ServerStreamingServer[serviceextension.GenerateVivoxTokenResponse]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshVivoxToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshVivoxToken indicates an expected call of RefreshVivoxToken.
+func (mr *MockServiceServerMockRecorder) RefreshVivoxToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshVivoxToken", reflect.TypeOf((*MockServiceServer)(nil).RefreshVivoxToken), arg0, arg1)
+}
+
+// ResolveVivoxUri mocks base method.
+func (m *MockServiceServer) ResolveVivoxUri(arg0 context.Context, arg1 *serviceextension.ResolveVivoxUriRequest) (*serviceextension.ResolveVivoxUriResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveVivoxUri", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.ResolveVivoxUriResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVivoxUri indicates an expected call of ResolveVivoxUri.
+func (mr *MockServiceServerMockRecorder) ResolveVivoxUri(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVivoxUri", reflect.TypeOf((*MockServiceServer)(nil).ResolveVivoxUri), arg0, arg1)
+}
+
+// VerifyVivoxToken mocks base method.
+func (m *MockServiceServer) VerifyVivoxToken(arg0 context.Context, arg1 *serviceextension.VerifyVivoxTokenRequest) (*serviceextension.VerifyVivoxTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyVivoxToken", arg0, arg1)
+	ret0, _ := ret[0].(*serviceextension.VerifyVivoxTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyVivoxToken indicates an expected call of VerifyVivoxToken.
+func (mr *MockServiceServerMockRecorder) VerifyVivoxToken(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedServiceServer", reflect.TypeOf((*MockServiceServer)(nil).mustEmbedUnimplementedServiceServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyVivoxToken", reflect.TypeOf((*MockServiceServer)(nil).VerifyVivoxToken), arg0, arg1)
 }
 
 // MockUnsafeServiceServer is a mock of UnsafeServiceServer interface.
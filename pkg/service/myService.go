@@ -9,12 +9,19 @@ import (
 	"strings"
 	"time"
 
+	"extend-rtu-vivox-authorization-service/pkg/audit"
 	utils "extend-rtu-vivox-authorization-service/pkg/common"
 	pb "extend-rtu-vivox-authorization-service/pkg/pb"
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/pkg/errors"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type MyServiceServerImpl struct {
@@ -22,111 +29,922 @@ type MyServiceServerImpl struct {
 	tokenRepo   repository.TokenRepository
 	configRepo  repository.ConfigRepository
 	refreshRepo repository.RefreshTokenRepository
-	claims      *Claims
+	config      *utils.Config
+	claims      *vivox.Claims
+	serials     vivox.SerialSource
+	tenants     *TenantRegistry
+	audit       *audit.Logger
+	bans        BanStore
+	iamUsers    *iam.UsersService
+	channels    ChannelAuthorizer
+	sessions    SessionLookup
+	rateLimiter *utils.RateLimiter
+	// waits between refreshed tokens, replaced in tests
+	after func(time.Duration) <-chan time.Time
+}
+
+// ServerOption configures optional dependencies of MyServiceServerImpl
+type ServerOption func(*MyServiceServerImpl)
+
+// WithSerialSource sets the source of token serial numbers, defaults to cryptographically random serials
+func WithSerialSource(serials vivox.SerialSource) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.serials = serials
+	}
+}
+
+// WithTenantRegistry sets the Vivox tenants per namespace, defaults to the single tenant of the config
+func WithTenantRegistry(tenants *TenantRegistry) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.tenants = tenants
+	}
+}
+
+// WithAuditLogger records the issued tokens of the audited actions, no audit log is kept by default
+func WithAuditLogger(logger *audit.Logger) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.audit = logger
+	}
+}
+
+// WithBanStore sets where voice bans are kept, defaults to memory
+func WithBanStore(bans BanStore) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.bans = bans
+	}
+}
+
+// WithChannelAuthorizer checks that users may be issued tokens in the channels of their requests, any channel is allowed by default
+func WithChannelAuthorizer(channels ChannelAuthorizer) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.channels = channels
+	}
+}
+
+// WithSessionLookup sets where the sessions of JoinSessionVoice are read, the RPC is unimplemented without it
+func WithSessionLookup(sessions SessionLookup) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.sessions = sessions
+	}
+}
+
+// WithRateLimiter charges the tokens refreshed by RefreshVivoxToken after the first one, which the rate limit interceptor charges
+func WithRateLimiter(limiter *utils.RateLimiter) ServerOption {
+	return func(s *MyServiceServerImpl) {
+		s.rateLimiter = limiter
+	}
 }
 
 func NewMyServiceServer(
 	tokenRepo repository.TokenRepository,
 	configRepo repository.ConfigRepository,
 	refreshRepo repository.RefreshTokenRepository,
-	claims *Claims,
+	config *utils.Config,
+	claims *vivox.Claims,
+	opts ...ServerOption,
 ) *MyServiceServerImpl {
-	return &MyServiceServerImpl{
+	s := &MyServiceServerImpl{
 		tokenRepo:   tokenRepo,
 		configRepo:  configRepo,
 		refreshRepo: refreshRepo,
+		config:      config,
 		claims:      claims,
+		serials:     vivox.NewCryptoSerialSource(),
+		tenants:     NewSingleTenantRegistry(configTenant(config.Vivox)),
+		bans:        NewMemoryBanStore(),
+		after:       time.After,
 	}
+	if config.Vivox.IAMBansEnabled && config.Vivox.IAMBansLookup {
+		s.iamUsers = &iam.UsersService{
+			Client:           factory.NewIamClient(configRepo),
+			ConfigRepository: configRepo,
+			TokenRepository:  tokenRepo,
+		}
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
-var (
-	issuer     = utils.GetEnv("VIVOX_ISSUER", "")
-	domain     = utils.GetEnv("VIVOX_DOMAIN", "")
-	signingKey = utils.GetEnv("VIVOX_SIGNING_KEY", "")
+// configTenant is the Vivox tenant serving every namespace when no tenants file is configured
+func configTenant(config utils.VivoxConfig) Tenant {
+	return Tenant{
+		Issuer:        config.Issuer,
+		Domain:        config.Domain,
+		SigningKey:    config.SigningKey,
+		Protocol:      config.Protocol,
+		ChannelPrefix: config.ChannelPrefix,
+	}
+}
+
+// LoadTenantRegistryFromConfig loads the configured tenants file, or serves every namespace with the configured tenant
+func LoadTenantRegistryFromConfig(config utils.VivoxConfig) (*TenantRegistry, error) {
+	if config.TenantsFile != "" {
+		return LoadTenantRegistry(config.TenantsFile)
+	}
+
+	tenant := configTenant(config)
+	if config.SigningKeyFile != "" {
+		keys, err := LoadKeyring(config.SigningKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tenant.Keys = keys
+	}
 
-	// optional
-	expiry   = utils.GetEnvInt("VIVOX_DEFAULT_EXPIRY", 90)
-	protocol = utils.GetEnv("VIVOX_PROTOCOL", "sip")
-	cPrefix  = utils.GetEnv("VIVOX_CHANNEL_PREFIX", "confctl")
+	return NewSingleTenantRegistry(tenant), nil
+}
+
+const (
+	minFadeIntensity = 0.1
+	maxFadeIntensity = 2.0
+
+	maxBatchTokens = 16
 )
 
 func (g MyServiceServerImpl) GenerateVivoxToken(
 	ctx context.Context, req *pb.GenerateVivoxTokenRequest,
 ) (*pb.GenerateVivoxTokenResponse, error) {
-	var accessToken, uri string
-	var err error
+	return g.generateVivoxToken(ctx, req, g.channels)
+}
 
+// generateVivoxToken issues the token of req, checking its channel with channels unless nil
+func (g MyServiceServerImpl) generateVivoxToken(
+	ctx context.Context, req *pb.GenerateVivoxTokenRequest, channels ChannelAuthorizer,
+) (*pb.GenerateVivoxTokenResponse, error) {
 	if errValidate := g.validateRequest(req); errValidate != nil {
+		utils.ValidationFailures.WithLabelValues(validationInvalidRequest).Inc()
+
 		return nil, errValidate
 	}
 
-	expiry := time.Now().Add(time.Duration(expiry) * time.Second)
-	uniqueNum := utils.RandomNumber(4)
-	cTypeStr := req.ChannelType.String()
+	ttl, err := g.tokenTTL(req.Type, req.ExpiresInSeconds)
+	if err != nil {
+		utils.ValidationFailures.WithLabelValues(validationTTLOutOfBounds).Inc()
+
+		return nil, err
+	}
+
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Type == pb.GenerateVivoxTokenRequestType_transcription && !g.isTranscriptionEnabled(tenant.Namespace) {
+		utils.AuthFailures.WithLabelValues(utils.AuthFailureTranscriptionDisabled).Inc()
+
+		return nil, status.Errorf(codes.PermissionDenied, "transcription is not enabled for namespace %s", tenant.Namespace)
+	}
+
+	if errAuthorize := g.authorizeUsername(ctx, tenant.Namespace, req.Username); errAuthorize != nil {
+		return nil, errAuthorize
+	}
+
+	if errBanned := g.checkBans(ctx, tenant.Namespace, req); errBanned != nil {
+		return nil, errBanned
+	}
+
+	if errBanned := g.checkIAMBans(ctx, tenant.Namespace, req); errBanned != nil {
+		return nil, errBanned
+	}
+
+	issuer := tenant.issuer(vivox.WithSerialSource(g.serials), vivox.WithIDEncoding(vivox.IDEncoding(g.config.Vivox.IDEncoding)))
+	channel := vivox.Channel{Type: vivox.ChannelType(req.ChannelType.String()), ID: req.ChannelId}
+	if props := req.ChannelProperties; props != nil {
+		channel.Properties = &vivox.ChannelProperties{
+			AudibleDistance:        props.AudibleDistance,
+			ConversationalDistance: props.ConversationalDistance,
+			FadeIntensity:          props.FadeIntensity,
+			FadeModel:              int32(props.FadeModel),
+		}
+	}
+
+	// the IDs are checked before the channel is authorized, which is before the token is signed
+	if g.claims == nil {
+		if errValidate := checkIDs(issuer, req, channel); errValidate != nil {
+			return nil, errValidate
+		}
+	}
+	if errAuthorize := authorizeChannel(ctx, channels, tenant.Namespace, req); errAuthorize != nil {
+		return nil, errAuthorize
+	}
 
 	// Route based on Enum
-	switch req.Type {
-	case pb.GenerateVivoxTokenRequestType_login:
-		accessToken, uri, err = GenerateVivocLoginToken(
-			signingKey,
-			issuer,
-			domain,
-			req.Username,
-			uniqueNum,
-			expiry,
-			g.claims,
-		)
-
-	case pb.GenerateVivoxTokenRequestType_join:
-		accessToken, uri, err = GenerateVivoxJoinToken(
-			signingKey,
-			issuer,
-			domain,
-			req.Username,
-			cTypeStr,
-			req.ChannelId,
-			uniqueNum,
-			expiry,
-			g.claims,
-		)
-
-	case pb.GenerateVivoxTokenRequestType_join_muted:
-		accessToken, uri, err = GenerateVivoxJoinMuteToken(
-			signingKey,
-			issuer,
-			domain,
-			req.Username,
-			cTypeStr,
-			req.ChannelId,
-			uniqueNum,
-			expiry,
-			g.claims,
-		)
+	var token vivox.Token
+	signingStart := time.Now()
+	switch {
+	case g.claims != nil:
+		// fixed claims replace the requested ones, used to reproduce known tokens
+		token = vivox.Token{Claims: *g.claims}
+		token.AccessToken, err = issuer.Sign(*g.claims)
 
-	case pb.GenerateVivoxTokenRequestType_kick:
-		accessToken, uri, err = GenerateVivoxKickToken(
-			signingKey,
-			issuer,
-			domain,
-			req.Username,
-			req.TargetUsername,
-			cTypeStr,
-			req.ChannelId,
-			uniqueNum,
-			expiry,
-			g.claims,
-		)
+	case req.Type == pb.GenerateVivoxTokenRequestType_login:
+		token, err = issuer.Login(req.Username, ttl)
+
+	case req.Type == pb.GenerateVivoxTokenRequestType_join:
+		token, err = issuer.Join(req.Username, channel, ttl)
+
+	case req.Type == pb.GenerateVivoxTokenRequestType_join_muted:
+		token, err = issuer.JoinMuted(req.Username, channel, ttl)
+
+	case req.Type == pb.GenerateVivoxTokenRequestType_kick:
+		token, err = issuer.Kick(req.Username, req.TargetUsername, channel, ttl)
+
+	case req.Type == pb.GenerateVivoxTokenRequestType_mute:
+		token, err = issuer.Mute(req.Username, req.TargetUsername, channel, ttl)
+
+	case req.Type == pb.GenerateVivoxTokenRequestType_transcription:
+		token, err = issuer.Transcription(req.Username, channel, ttl)
 
 	default:
+		utils.ValidationFailures.WithLabelValues(validationUnsupportedAction).Inc()
+
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported action type: %s", req.Type.String())
 	}
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error generate Vivox auth token: %v", err)
 	}
+	utils.SigningDuration.WithLabelValues(req.Type.String()).Observe(time.Since(signingStart).Seconds())
+
+	if errAudit := g.recordAudit(ctx, tenant.Namespace, req, token); errAudit != nil {
+		return nil, errAudit
+	}
+	utils.TokensIssued.WithLabelValues(req.Type.String(), channelTypeLabel(req, token), g.namespaceLabel(tenant.Namespace)).Inc()
 
 	// Return the token
-	return &pb.GenerateVivoxTokenResponse{AccessToken: accessToken, Uri: uri}, nil
+	return &pb.GenerateVivoxTokenResponse{
+		AccessToken: token.AccessToken,
+		Uri:         token.Claims.T,
+		ExpiresAt:   token.Claims.Exp,
+		FromUri:     token.Claims.F,
+		ToUri:       token.Claims.T,
+		SubUri:      token.Claims.Sub,
+		Serial:      token.Claims.Vxi,
+		Action:      token.Claims.Vxa,
+	}, nil
+}
+
+func (g MyServiceServerImpl) GenerateVivoxTokens(
+	ctx context.Context, req *pb.GenerateVivoxTokensRequest,
+) (*pb.GenerateVivoxTokensResponse, error) {
+	if len(req.GetTokens()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tokens are required")
+	}
+	if len(req.Tokens) > maxBatchTokens {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tokens can be requested at once", maxBatchTokens)
+	}
+
+	res := &pb.GenerateVivoxTokensResponse{Results: make([]*pb.GenerateVivoxTokensResult, 0, len(req.Tokens))}
+	for _, item := range req.Tokens {
+		var token *pb.GenerateVivoxTokenResponse
+		if item.GetNamespace() == "" && req.Namespace != "" {
+			// the caller's request is left as it is
+			item = proto.Clone(item).(*pb.GenerateVivoxTokenRequest)
+			item.Namespace = req.Namespace
+		}
+		err := g.authorizeAction(ctx, item)
+		if err == nil {
+			token, err = g.GenerateVivoxToken(ctx, item)
+		}
+
+		if err != nil {
+			st := status.Convert(err)
+			res.Results = append(res.Results, &pb.GenerateVivoxTokensResult{
+				Error: &pb.GenerateVivoxTokensError{Code: int32(st.Code()), Message: st.Message()},
+			})
+		} else {
+			res.Results = append(res.Results, &pb.GenerateVivoxTokensResult{Token: token})
+		}
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) JoinSessionVoice(
+	ctx context.Context, req *pb.JoinSessionVoiceRequest,
+) (*pb.JoinSessionVoiceResponse, error) {
+	if g.sessions == nil {
+		return nil, status.Error(codes.Unimplemented, "session voice is not configured")
+	}
+	if req == nil || strings.TrimSpace(req.SessionId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	username := req.Username
+	if authInfo, found := utils.AuthInfoFromContext(ctx); found && username == "" {
+		username = authInfo.UserID()
+	}
+	if strings.TrimSpace(username) == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	// before the lookup, so that callers cannot probe the sessions of other users
+	if errAuthorize := g.authorizeUsername(ctx, tenant.Namespace, username); errAuthorize != nil {
+		return nil, errAuthorize
+	}
+
+	s, err := g.sessions.LookupSession(ctx, tenant.Namespace, req.SessionId)
+	if errors.Is(err, ErrSessionNotFound) {
+		return nil, status.Errorf(codes.NotFound, "session %s not found in namespace %s", req.SessionId, tenant.Namespace)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error look up session %s: %v", req.SessionId, err)
+	}
+	if !s.IsCurrentMember(username) {
+		utils.AuthFailures.WithLabelValues(utils.AuthFailureChannelAccessDenied).Inc()
+
+		return nil, status.Errorf(codes.PermissionDenied, "user %s is not a member of session %s", username, s.ID)
+	}
+
+	res := &pb.JoinSessionVoiceResponse{
+		SessionId:   s.ID,
+		SessionType: pb.JoinSessionVoiceSessionType_game_session,
+		Template:    s.Template,
+		ChannelType: sessionChannelType(g.config.Vivox, s, 0),
+		ChannelId:   s.ChannelID(0),
+	}
+	if s.Kind == SessionKindParty {
+		res.SessionType = pb.JoinSessionVoiceSessionType_party_session
+	}
+
+	// the channels are derived from the session the user was just found in, they are not looked up again
+	tokenReq := func(action pb.GenerateVivoxTokenRequestType) *pb.GenerateVivoxTokenRequest {
+		return &pb.GenerateVivoxTokenRequest{
+			Type:             action,
+			Username:         username,
+			Namespace:        tenant.Namespace,
+			ExpiresInSeconds: req.ExpiresInSeconds,
+		}
+	}
+	if res.Login, err = g.generateVivoxToken(ctx, tokenReq(pb.GenerateVivoxTokenRequestType_login), nil); err != nil {
+		return nil, err
+	}
+	join := tokenReq(pb.GenerateVivoxTokenRequestType_join)
+	join.ChannelId, join.ChannelType = res.ChannelId, res.ChannelType
+	if res.Join, err = g.generateVivoxToken(ctx, join, nil); err != nil {
+		return nil, err
+	}
+
+	if team := s.Team(username); req.TeamChannel && team > 0 {
+		res.TeamChannelId = s.ChannelID(team)
+		teamJoin := tokenReq(pb.GenerateVivoxTokenRequestType_join)
+		teamJoin.ChannelId, teamJoin.ChannelType = res.TeamChannelId, sessionChannelType(g.config.Vivox, s, team)
+		if res.TeamJoin, err = g.generateVivoxToken(ctx, teamJoin, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) RefreshVivoxToken(
+	req *pb.GenerateVivoxTokenRequest, stream pb.Service_RefreshVivoxTokenServer,
+) error {
+	switch req.GetType() {
+	case pb.GenerateVivoxTokenRequestType_login,
+		pb.GenerateVivoxTokenRequestType_join,
+		pb.GenerateVivoxTokenRequestType_join_muted:
+	default:
+		// moderation tokens are issued once per action, they are not kept alive
+		return status.Errorf(codes.InvalidArgument, "only login, join and join_muted tokens can be refreshed, not %s", req.GetType().String())
+	}
+
+	ctx := stream.Context()
+	for refreshed := false; ; refreshed = true {
+		// re-check permissions on every refresh, so revoked or banned callers lose their stream
+		if err := g.authorizeAction(ctx, req); err != nil {
+			return err
+		}
+		// an open stream counts its tokens against the same limits as single requests
+		if refreshed && g.rateLimiter != nil {
+			if err := utils.CheckRateLimit(ctx, g.rateLimiter, req); err != nil {
+				return err
+			}
+		}
+
+		res, err := g.GenerateVivoxToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(res); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-g.after(g.refreshDelay(time.Unix(res.ExpiresAt, 0))):
+		}
+	}
+}
+
+// refreshDelay is the wait before refreshing a token expiring at expiresAt, at least half its remaining lifetime
+func (g MyServiceServerImpl) refreshDelay(expiresAt time.Time) time.Duration {
+	remaining := time.Until(expiresAt)
+	delay := remaining - time.Duration(g.config.Vivox.RefreshLead)*time.Second
+
+	return max(delay, remaining/2)
+}
+
+func (g MyServiceServerImpl) VerifyVivoxToken(
+	ctx context.Context, req *pb.VerifyVivoxTokenRequest,
+) (*pb.VerifyVivoxTokenResponse, error) {
+	if req == nil || strings.TrimSpace(req.AccessToken) == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := tenant.verify(req.AccessToken, time.Now())
+	if err == nil && claims.Iss != tenant.Issuer {
+		err = errors.Wrapf(vivox.ErrTokenIssuerMismatch, "expected %q, got %q", tenant.Issuer, claims.Iss)
+	}
+
+	res := &pb.VerifyVivoxTokenResponse{
+		Valid:  err == nil,
+		Reason: verifyFailureReason(err),
+	}
+	if err != nil {
+		res.Message = err.Error()
+	}
+	if claims != nil {
+		res.Claims = &pb.VivoxTokenClaims{
+			Vxi: claims.Vxi,
+			Sub: claims.Sub,
+			F:   claims.F,
+			Iss: claims.Iss,
+			Vxa: claims.Vxa,
+			T:   claims.T,
+			Exp: claims.Exp,
+		}
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) ResolveVivoxUri(
+	ctx context.Context, req *pb.ResolveVivoxUriRequest,
+) (*pb.ResolveVivoxUriResponse, error) {
+	if req == nil || strings.TrimSpace(req.Uri) == "" {
+		return nil, status.Error(codes.InvalidArgument, "uri is required")
+	}
+
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := tenant.issuer(vivox.WithIDEncoding(vivox.IDEncoding(g.config.Vivox.IDEncoding))).ParseURI(req.Uri)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "uri is not a Vivox URI of namespace %s: %v", tenant.Namespace, err)
+	}
+
+	res := &pb.ResolveVivoxUriResponse{
+		Namespace: tenant.Namespace,
+		Issuer:    parsed.Issuer,
+	}
+	switch parsed.Kind {
+	case vivox.URIKindUser:
+		res.Kind = pb.VivoxUriKind_vivoxurikind_user
+		res.UserId = parsed.UserID
+	case vivox.URIKindChannel:
+		res.Kind = pb.VivoxUriKind_vivoxurikind_channel
+		res.ChannelType = pb.GenerateVivoxTokenRequestChannelType(pb.GenerateVivoxTokenRequestChannelType_value[string(parsed.Channel.Type)])
+		res.ChannelId = parsed.Channel.ID
+		if props := parsed.Channel.Properties; props != nil {
+			res.ChannelProperties = &pb.GenerateVivoxTokenRequestChannelProperties{
+				AudibleDistance:        props.AudibleDistance,
+				ConversationalDistance: props.ConversationalDistance,
+				FadeIntensity:          props.FadeIntensity,
+				FadeModel:              pb.GenerateVivoxTokenRequestFadeModel(props.FadeModel),
+			}
+		}
+	case vivox.URIKindServer:
+		res.Kind = pb.VivoxUriKind_vivoxurikind_server
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) ListVivoxSigningKeys(
+	ctx context.Context, req *pb.ListVivoxSigningKeysRequest,
+) (*pb.ListVivoxSigningKeysResponse, error) {
+	tenant, err := g.resolveTenant(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &pb.ListVivoxSigningKeysResponse{
+		Namespace: tenant.Namespace,
+		LoadedAt:  tenant.Keys.LoadedAt().Unix(),
+	}
+	if reloadErr := tenant.Keys.ReloadError(); reloadErr != nil {
+		res.ReloadError = reloadErr.Error()
+	}
+	for _, version := range tenant.Keys.Versions() {
+		key := &pb.VivoxSigningKeyVersion{Version: version.Version, Active: version.Active}
+		if version.RetireAt != nil {
+			key.RetireAt = version.RetireAt.Unix()
+			key.Retired = !version.Active && !now.Before(*version.RetireAt)
+		}
+		res.Keys = append(res.Keys, key)
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) CreateVivoxBan(
+	ctx context.Context, req *pb.CreateVivoxBanRequest,
+) (*pb.CreateVivoxBanResponse, error) {
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	now := time.Now()
+	ban := Ban{UserID: req.UserId, Reason: req.Reason, CreatedAt: now.UTC()}
+	switch req.Scope {
+	case pb.VivoxBanScope_global_ban:
+		if req.ChannelId != "" {
+			return nil, status.Error(codes.InvalidArgument, "channel_id is only supported for channel bans")
+		}
+		ban.Scope = BanScopeGlobal
+	case pb.VivoxBanScope_channel_ban:
+		if strings.TrimSpace(req.ChannelId) == "" {
+			return nil, status.Error(codes.InvalidArgument, "channel_id is required for channel bans")
+		}
+		ban.Scope, ban.ChannelID = BanScopeChannel, req.ChannelId
+	default:
+		return nil, status.Error(codes.InvalidArgument, "valid scope is required. Please use one of these values: global_ban or channel_ban.")
+	}
+
+	switch {
+	case req.ExpiresAt != 0 && req.DurationSeconds != 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of expires_at or duration_seconds can be set")
+	case req.DurationSeconds > 0:
+		ban.ExpiresAt = now.Add(time.Duration(req.DurationSeconds) * time.Second).UTC()
+	case req.ExpiresAt > now.Unix():
+		ban.ExpiresAt = time.Unix(req.ExpiresAt, 0).UTC()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "expires_at in the future or a positive duration_seconds is required")
+	}
+
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ban.Namespace = tenant.Namespace
+	if authInfo, found := utils.AuthInfoFromContext(ctx); found {
+		ban.CreatedBy = authInfo.UserID()
+		if ban.CreatedBy == "" {
+			ban.CreatedBy = authInfo.Claims.ClientID
+		}
+	}
+
+	if ban.ID, err = newBanID(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error create voice ban: %v", err)
+	}
+	if err = g.bans.Create(ctx, ban); err != nil {
+		return nil, status.Errorf(codes.Internal, "error create voice ban: %v", err)
+	}
+
+	return &pb.CreateVivoxBanResponse{Ban: banResponse(ban)}, nil
+}
+
+func (g MyServiceServerImpl) ListVivoxBans(
+	ctx context.Context, req *pb.ListVivoxBansRequest,
+) (*pb.ListVivoxBansResponse, error) {
+	tenant, err := g.resolveTenant(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	bans, err := g.bans.List(ctx, tenant.Namespace, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error list voice bans: %v", err)
+	}
+
+	res := &pb.ListVivoxBansResponse{Bans: make([]*pb.VivoxBan, 0, len(bans))}
+	for _, ban := range bans {
+		res.Bans = append(res.Bans, banResponse(ban))
+	}
+
+	return res, nil
+}
+
+func (g MyServiceServerImpl) LiftVivoxBan(
+	ctx context.Context, req *pb.LiftVivoxBanRequest,
+) (*pb.LiftVivoxBanResponse, error) {
+	if strings.TrimSpace(req.GetBanId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ban_id is required")
+	}
+
+	tenant, err := g.resolveTenant(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	ban, err := g.bans.Delete(ctx, tenant.Namespace, req.BanId)
+	if errors.Is(err, ErrBanNotFound) {
+		return nil, status.Errorf(codes.NotFound, "voice ban %s not found in namespace %s", req.BanId, tenant.Namespace)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error lift voice ban: %v", err)
+	}
+
+	return &pb.LiftVivoxBanResponse{Ban: banResponse(ban)}, nil
+}
+
+func banResponse(ban Ban) *pb.VivoxBan {
+	res := &pb.VivoxBan{
+		BanId:     ban.ID,
+		Namespace: ban.Namespace,
+		UserId:    ban.UserID,
+		Scope:     pb.VivoxBanScope_global_ban,
+		ChannelId: ban.ChannelID,
+		Reason:    ban.Reason,
+		CreatedAt: ban.CreatedAt.Unix(),
+		ExpiresAt: ban.ExpiresAt.Unix(),
+		CreatedBy: ban.CreatedBy,
+	}
+	if ban.Scope == BanScopeChannel {
+		res.Scope = pb.VivoxBanScope_channel_ban
+	}
+
+	return res
+}
+
+// requestFields maps the ID fields of the vivox package to the request fields they are taken from
+var requestFields = map[string]string{
+	vivox.FieldUserID:       "username",
+	vivox.FieldTargetUserID: "target_username",
+	vivox.FieldChannelID:    "channel_id",
+}
+
+// reasons of the validation failures metric, IDs not accepted by Vivox are counted as invalid_<field>
+const (
+	validationInvalidRequest    = "invalid_request"
+	validationTTLOutOfBounds    = "ttl_out_of_bounds"
+	validationUnsupportedAction = "unsupported_action"
+)
+
+// channelTypeLabel is the channel type of the token, none for tokens without channel
+func channelTypeLabel(req *pb.GenerateVivoxTokenRequest, token vivox.Token) string {
+	if req.ChannelId == "" || token.Claims.T == "" {
+		return "none"
+	}
+	switch req.ChannelType {
+	case pb.GenerateVivoxTokenRequestChannelType_echo,
+		pb.GenerateVivoxTokenRequestChannelType_positional,
+		pb.GenerateVivoxTokenRequestChannelType_nonpositional:
+		return req.ChannelType.String()
+	default:
+		return "none"
+	}
+}
+
+// namespaceLabel keeps the namespaces of the metrics to the configured ones, any namespace is served without a tenants file
+func (g MyServiceServerImpl) namespaceLabel(ns string) string {
+	if ns == g.config.Namespace || g.tenants.Configured(ns) {
+		return ns
+	}
+
+	return "other"
+}
+
+// recordAudit records who was issued token when its action is audited. The token is not returned when it cannot be recorded.
+func (g MyServiceServerImpl) recordAudit(ctx context.Context, namespace string, req *pb.GenerateVivoxTokenRequest, token vivox.Token) error {
+	if g.audit == nil {
+		return nil
+	}
+
+	entry := audit.Entry{
+		Action:    req.Type.String(),
+		Namespace: namespace,
+		UserID:    req.Username,
+		FromURI:   token.Claims.F,
+		ToURI:     token.Claims.T,
+		SubURI:    token.Claims.Sub,
+		Serial:    token.Claims.Vxi,
+		ExpiresAt: token.Claims.Exp,
+	}
+	// the request may set fields its action does not use
+	if token.Claims.Sub != "" {
+		entry.TargetUserID = req.TargetUsername
+	}
+	if token.Claims.T != "" {
+		entry.ChannelID = req.ChannelId
+	}
+	if authInfo, found := utils.AuthInfoFromContext(ctx); found {
+		entry.CallerUserID, entry.CallerClientID = authInfo.UserID(), authInfo.Claims.ClientID
+	}
+	if err := g.audit.Record(entry); err != nil {
+		return status.Errorf(codes.Internal, "error record audit entry: %v", err)
+	}
+
+	return nil
+}
+
+// checkBans rejects the tokens of a user banned from voice, globally or from the channel of the token.
+// When several bans apply, the error tells when the last one ends.
+func (g MyServiceServerImpl) checkBans(ctx context.Context, namespace string, req *pb.GenerateVivoxTokenRequest) error {
+	bans, err := g.bans.List(ctx, namespace, req.Username)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error check voice bans: %v", err)
+	}
+
+	// login tokens have no channel, whatever the request holds
+	channelID := req.ChannelId
+	if req.Type == pb.GenerateVivoxTokenRequestType_login {
+		channelID = ""
+	}
+	var banned *Ban
+	for i, ban := range bans {
+		if ban.Covers(channelID) && (banned == nil || ban.ExpiresAt.After(banned.ExpiresAt)) {
+			banned = &bans[i]
+		}
+	}
+	if banned == nil {
+		return nil
+	}
+	utils.AuthFailures.WithLabelValues(utils.AuthFailureVoiceBanned).Inc()
+
+	until := banned.ExpiresAt.UTC().Format(time.RFC3339)
+	message := "user " + req.Username + " is banned from voice until " + until
+	if banned.Scope == BanScopeChannel {
+		message = "user " + req.Username + " is banned from channel " + banned.ChannelID + " until " + until
+	}
+	if banned.Reason != "" {
+		message += ": " + banned.Reason
+	}
+	st := status.New(codes.PermissionDenied, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "VOICE_BANNED",
+		Domain: "vivox",
+		Metadata: map[string]string{
+			"banId":     banned.ID,
+			"scope":     string(banned.Scope),
+			"expiresAt": until,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// checkIDs rejects the user, target and channel IDs of req that cannot be used in the URIs of its token
+func checkIDs(issuer *vivox.Issuer, req *pb.GenerateVivoxTokenRequest, channel vivox.Channel) error {
+	_, err := issuer.UserURI(req.Username)
+	if err == nil && (req.Type == pb.GenerateVivoxTokenRequestType_kick || req.Type == pb.GenerateVivoxTokenRequestType_mute) {
+		_, err = issuer.TargetUserURI(req.TargetUsername)
+	}
+	// login tokens have no channel
+	if err == nil && req.Type != pb.GenerateVivoxTokenRequestType_login {
+		_, err = issuer.ChannelURI(channel)
+	}
+
+	var idErr *vivox.IDError
+	if errors.As(err, &idErr) {
+		utils.ValidationFailures.WithLabelValues("invalid_" + idErr.Field).Inc()
+
+		return invalidIDStatus(idErr)
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "error check Vivox IDs: %v", err)
+	}
+
+	return nil
+}
+
+// invalidIDStatus is an InvalidArgument status naming the request field and the rule it breaks
+func invalidIDStatus(idErr *vivox.IDError) error {
+	field := requestFields[idErr.Field]
+	st := status.Newf(codes.InvalidArgument, "%s %s", field, idErr.Rule)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: idErr.Rule}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func verifyFailureReason(err error) pb.VerifyVivoxTokenFailureReason {
+	switch {
+	case err == nil:
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_none
+	case errors.Is(err, vivox.ErrTokenMalformed):
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_malformed
+	case errors.Is(err, vivox.ErrTokenSignatureInvalid):
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_invalid_signature
+	case errors.Is(err, vivox.ErrTokenExpired):
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_expired
+	case errors.Is(err, vivox.ErrTokenIssuerMismatch):
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_issuer_mismatch
+	default:
+		return pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_malformed
+	}
+}
+
+// resolveTenant selects the tenant of the namespace in the request path, or of the caller's token when the path has none.
+// Requesting another namespace than the caller's own requires the admin permission.
+func (g MyServiceServerImpl) resolveTenant(ctx context.Context, reqNamespace string) (Tenant, error) {
+	ns := reqNamespace
+	if authInfo, found := utils.AuthInfoFromContext(ctx); found && authInfo.Claims.Namespace != "" {
+		if ns == "" {
+			ns = authInfo.Claims.Namespace
+		} else if ns != authInfo.Claims.Namespace {
+			permission := g.adminPermission()
+			if err := utils.CheckPermission(ctx, &permission, ns); err != nil {
+				return Tenant{}, status.Errorf(codes.PermissionDenied, "namespace %s does not match the authenticated user", ns)
+			}
+		}
+	}
+	if ns == "" {
+		ns = g.config.Namespace
+	}
+
+	tenant, err := g.tenants.Lookup(ns)
+	if errors.Is(err, ErrTenantNotFound) {
+		return Tenant{}, status.Errorf(codes.NotFound, "vivox is not configured for namespace %s", ns)
+	} else if err != nil {
+		return Tenant{}, status.Errorf(codes.Internal, "error resolving vivox tenant: %v", err)
+	}
+
+	isInvalid := func(s string) bool {
+		return s == "" || strings.ToLower(s) == "string"
+	}
+	if isInvalid(tenant.signingKey()) || isInvalid(tenant.Issuer) || isInvalid(tenant.Domain) {
+		return Tenant{}, status.Error(codes.Internal, "vivox configuration (key/issuer/domain) is missing")
+	}
+
+	return tenant, nil
+}
+
+// authorizeAction checks the per-action permissions of a token spec the auth interceptor did not see, e.g. in a batch
+func (g MyServiceServerImpl) authorizeAction(ctx context.Context, req *pb.GenerateVivoxTokenRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request body cannot be nil")
+	}
+	if _, found := utils.AuthInfoFromContext(ctx); !found {
+		// authorization is disabled
+		return nil
+	}
+
+	namespace := utils.PermissionNamespace(ctx, req.Namespace)
+	for _, permission := range utils.RequestPermissions(req) {
+		if err := utils.CheckPermission(ctx, permission, namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorizeChannel asks channels whether the user may be issued the token in its channel, login tokens have none
+func authorizeChannel(ctx context.Context, channels ChannelAuthorizer, namespace string, req *pb.GenerateVivoxTokenRequest) error {
+	if channels == nil || req.Type == pb.GenerateVivoxTokenRequestType_login {
+		return nil
+	}
+
+	err := channels.AuthorizeChannel(ctx, namespace, req.Username, req.TargetUsername, req.ChannelId, req.ChannelType, req.Type)
+	if errors.Is(err, ErrChannelAccessDenied) {
+		utils.AuthFailures.WithLabelValues(utils.AuthFailureChannelAccessDenied).Inc()
+
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "error authorize channel %s: %v", req.ChannelId, err)
+	}
+
+	return nil
+}
+
+// authorizeUsername rejects a username other than the authenticated user, unless the caller holds the admin permission in namespace
+func (g MyServiceServerImpl) authorizeUsername(ctx context.Context, namespace, username string) error {
+	authInfo, found := utils.AuthInfoFromContext(ctx)
+	if !found {
+		// authorization is disabled
+		return nil
+	}
+
+	if userID := authInfo.UserID(); userID != "" && userID == username {
+		return nil
+	}
+
+	permission := g.adminPermission()
+	if err := utils.CheckPermission(ctx, &permission, namespace); err != nil {
+		return status.Errorf(codes.PermissionDenied, "username %s does not match the authenticated user", username)
+	}
+
+	return nil
 }
 
 func (g *MyServiceServerImpl) validateRequest(req *pb.GenerateVivoxTokenRequest) error {
@@ -138,10 +956,6 @@ func (g *MyServiceServerImpl) validateRequest(req *pb.GenerateVivoxTokenRequest)
 		return s == "" || strings.ToLower(s) == "string"
 	}
 
-	if isInvalid(signingKey) || isInvalid(issuer) || isInvalid(domain) {
-		return status.Error(codes.Internal, "vivox configuration (key/issuer/domain) is missing")
-	}
-
 	if req.Type == pb.GenerateVivoxTokenRequestType_generatevivoxtokenrequest_type_unknown {
 		return status.Error(codes.InvalidArgument, "a valid action type must be provided")
 	}
@@ -166,12 +980,87 @@ func (g *MyServiceServerImpl) validateRequest(req *pb.GenerateVivoxTokenRequest)
 
 	case pb.GenerateVivoxTokenRequestType_kick:
 		if isInvalid(req.ChannelId) {
-			return status.Error(codes.InvalidArgument, "channel_id are required for kick")
+			return status.Error(codes.InvalidArgument, "channel_id is required for kick")
 		}
 		if isInvalid(req.TargetUsername) {
-			return status.Error(codes.InvalidArgument, "target_username are required for kick")
+			return status.Error(codes.InvalidArgument, "target_username is required for kick")
+		}
+
+	case pb.GenerateVivoxTokenRequestType_mute:
+		if isInvalid(req.ChannelId) {
+			return status.Error(codes.InvalidArgument, "channel_id is required for mute")
+		}
+		if isInvalid(req.TargetUsername) {
+			return status.Error(codes.InvalidArgument, "target_username is required for mute")
+		}
+
+	case pb.GenerateVivoxTokenRequestType_transcription:
+		if isInvalid(req.ChannelId) {
+			return status.Error(codes.InvalidArgument, "channel_id is required for transcription")
+		}
+		cType := req.ChannelType.String()
+		if isInvalid(cType) || strings.Contains(strings.ToLower(cType), "unknown") {
+			return status.Error(codes.InvalidArgument, "valid channel_type is required. Please use one of these values: echo, positional, or nonpositional.")
+		}
+	}
+
+	if props := req.ChannelProperties; props != nil {
+		if req.ChannelType != pb.GenerateVivoxTokenRequestChannelType_positional || isInvalid(req.ChannelId) {
+			return status.Error(codes.InvalidArgument, "channel_properties are only supported for positional channels")
+		}
+		if props.ConversationalDistance <= 0 {
+			return status.Error(codes.InvalidArgument, "channel_properties.conversational_distance must be greater than 0")
+		}
+		if props.AudibleDistance <= props.ConversationalDistance {
+			return status.Error(codes.InvalidArgument, "channel_properties.audible_distance must be greater than conversational_distance")
+		}
+		if props.FadeIntensity < minFadeIntensity || props.FadeIntensity > maxFadeIntensity {
+			return status.Errorf(codes.InvalidArgument, "channel_properties.fade_intensity must be between %.1f and %.1f", minFadeIntensity, maxFadeIntensity)
+		}
+		switch props.FadeModel {
+		case pb.GenerateVivoxTokenRequestFadeModel_inverse_by_distance,
+			pb.GenerateVivoxTokenRequestFadeModel_linear_by_distance,
+			pb.GenerateVivoxTokenRequestFadeModel_exponential_by_distance:
+		default:
+			return status.Error(codes.InvalidArgument, "valid channel_properties.fade_model is required. Please use one of these values: inverse_by_distance, linear_by_distance, or exponential_by_distance.")
 		}
 	}
 
 	return nil
 }
+
+// tokenTTL returns the requested token lifetime within the bounds of the action, or its default when none is requested
+func (g MyServiceServerImpl) tokenTTL(action pb.GenerateVivoxTokenRequestType, expiresInSeconds int32) (time.Duration, error) {
+	if expiresInSeconds < 0 {
+		return 0, status.Error(codes.InvalidArgument, "expires_in_seconds must not be negative")
+	}
+
+	policy := g.config.Vivox.TTLPolicy(action.String())
+	seconds := int(expiresInSeconds)
+	switch {
+	case seconds == 0:
+		seconds = policy.Default
+	case seconds >= policy.Min && seconds <= policy.Max:
+	case g.config.Vivox.TTLOutOfBounds == utils.TTLOutOfBoundsClamp:
+		seconds = max(policy.Min, min(seconds, policy.Max))
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "expires_in_seconds must be between %d and %d for %s", policy.Min, policy.Max, action.String())
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// adminPermission allows callers to request tokens on behalf of other users and namespaces
+func (g MyServiceServerImpl) adminPermission() iam.Permission {
+	return iam.Permission{Resource: g.config.Vivox.AdminPermissionResource, Action: g.config.Vivox.AdminPermissionAction}
+}
+
+func (g MyServiceServerImpl) isTranscriptionEnabled(ns string) bool {
+	for _, enabled := range g.config.Vivox.TranscriptionNamespaces {
+		if enabled == "*" || enabled == ns {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,25 +7,57 @@ package service
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"extend-rtu-vivox-authorization-service/pkg/audit"
 	"extend-rtu-vivox-authorization-service/pkg/common"
 	pb "extend-rtu-vivox-authorization-service/pkg/pb"
 	"extend-rtu-vivox-authorization-service/pkg/service/mocks"
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
 
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/go-jose/jwt"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 //go:generate mockgen -destination ./mocks/server_mock.go -package mocks extend-custom-guild-service/pkg/pb myServiceServer
 //go:generate mockgen -destination ./mocks/repo_mock.go -package mocks github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository TokenRepository,ConfigRepository,RefreshTokenRepository
 
+const (
+	testIssuer     = "demo"
+	testDomain     = "tla.vivox.com"
+	testSigningKey = "secret!"
+)
+
+func testConfig() *common.Config {
+	config := common.DefaultConfig()
+	config.Vivox.Issuer = testIssuer
+	config.Vivox.Domain = testDomain
+	config.Vivox.SigningKey = testSigningKey
+
+	return &config
+}
+
+func testTenant() Tenant {
+	return withTenantDefaults(configTenant(testConfig().Vivox))
+}
+
 func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 	tests := []struct {
 		name          string
 		req           *pb.GenerateVivoxTokenRequest
-		claims        *Claims
+		claims        *vivox.Claims
 		wantErr       bool
 		expectedErr   error
 		expectedToken string
@@ -42,16 +74,16 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionLogin,
+				Vxa: vivox.ActionLogin,
 				Vxi: 933000,
 				F:   "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 			},
 			wantErr:       false,
 			expectedToken: "e30.eyJ2eGkiOjkzMzAwMCwiZiI6InNpcDouYmxpbmRtZWxvbi1BcHBOYW1lLWRldi5qZXJreS5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImJsaW5kbWVsb24tQXBwTmFtZS1kZXYiLCJ2eGEiOiJsb2dpbiIsImV4cCI6MTYwMDM0OTQwMH0.YJwjX0P2Pjk1dzFpIo1fjJM21pphfBwHm8vShJib8ds",
-			expectedUri:   fmt.Sprintf("sip:%s@tla.vivox.com", channelName(ChannelEcho, "blindmelon-AppName-dev", "933000")),
+			expectedUri:   "sip:confctl-e-blindmelon-AppName-dev.933000@tla.vivox.com",
 		},
 		{
 			// Test values taken from:
@@ -60,14 +92,14 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 			req: &pb.GenerateVivoxTokenRequest{
 				Type:           pb.GenerateVivoxTokenRequestType_join,
 				Username:       "jerky",
-				ChannelId:      "sip:confctl-g-blindmelon.testchannel@tla.vivox.com",
+				ChannelId:      "testchannel",
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionJoin,
+				Vxa: vivox.ActionJoin,
 				Vxi: 444000,
 				F:   "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -83,14 +115,14 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 			req: &pb.GenerateVivoxTokenRequest{
 				Type:           pb.GenerateVivoxTokenRequestType_join_muted,
 				Username:       "jerky",
-				ChannelId:      "sip:confctl-g-blindmelon.testchannel@tla.vivox.com",
+				ChannelId:      "testchannel",
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionJoinMuted,
+				Vxa: vivox.ActionJoinMuted,
 				Vxi: 542680,
 				F:   "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -110,11 +142,11 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				Exp: 1600349400,
-				Vxa: ActionKick,
+				Vxa: vivox.ActionKick,
 				Vxi: 665000,
 				F:   "sip:.blindmelon-AppName-dev.beef.@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -134,11 +166,11 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				Exp: 1600349400,
-				Vxa: ActionKick,
+				Vxa: vivox.ActionKick,
 				Vxi: 8000,
 				F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -154,22 +186,11 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 			req: &pb.GenerateVivoxTokenRequest{
 				Type:           pb.GenerateVivoxTokenRequestType_kick,
 				Username:       "blindmelon-AppName-dev-Admin",
-				ChannelId:      "", // entire server
+				ChannelId:      "", // entire server, not issued by the service
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
-				Iss: "blindmelon-AppName-dev",
-				Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
-				Exp: 1600349400,
-				Vxa: ActionKick,
-				Vxi: 613642,
-				F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
-				T:   "sip:blindmelon-AppName-dev-service@tla.vivox.com",
-			},
-			wantErr:       false,
-			expectedToken: "e30.eyJ2eGkiOjYxMzY0Miwic3ViIjoic2lwOi5ibGluZG1lbG9uLUFwcE5hbWUtZGV2Lmplcmt5LkB0bGEudml2b3guY29tIiwiZiI6InNpcDpibGluZG1lbG9uLUFwcE5hbWUtZGV2LUFkbWluQHRsYS52aXZveC5jb20iLCJpc3MiOiJibGluZG1lbG9uLUFwcE5hbWUtZGV2IiwidnhhIjoia2ljayIsInQiOiJzaXA6YmxpbmRtZWxvbi1BcHBOYW1lLWRldi1zZXJ2aWNlQHRsYS52aXZveC5jb20iLCJleHAiOjE2MDAzNDk0MDB9.jinc73lQ_ZSN4Mb8WLFK7Clu-Se9LG-QifXKfpaa3g4",
-			expectedUri:   "",
+			wantErr: true,
 		},
 		{
 			// Test values taken from:
@@ -182,10 +203,10 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionKick,
+				Vxa: vivox.ActionKick,
 				Vxi: 729614,
 				F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -205,11 +226,11 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				Exp: 1600349400,
-				Vxa: ActionMute,
+				Vxa: vivox.ActionMute,
 				Vxi: 123456,
 				F:   "sip:.blindmelon-AppName-dev.beef.@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -229,11 +250,11 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
 				Exp: 1600349400,
-				Vxa: ActionMute,
+				Vxa: vivox.ActionMute,
 				Vxi: 654321,
 				F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -242,6 +263,26 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 			expectedToken: "e30.eyJ2eGkiOjY1NDMyMSwic3ViIjoic2lwOi5ibGluZG1lbG9uLUFwcE5hbWUtZGV2Lmplcmt5LkB0bGEudml2b3guY29tIiwiZiI6InNpcDpibGluZG1lbG9uLUFwcE5hbWUtZGV2LUFkbWluQHRsYS52aXZveC5jb20iLCJpc3MiOiJibGluZG1lbG9uLUFwcE5hbWUtZGV2IiwidnhhIjoibXV0ZSIsInQiOiJzaXA6Y29uZmN0bC1nLWJsaW5kbWVsb24tQXBwTmFtZS1kZXYudGVzdGNoYW5uZWxAdGxhLnZpdm94LmNvbSIsImV4cCI6MTYwMDM0OTQwMH0.ix0mFGS1XDXCBXH044f6B2JxutExbH2hZjGqZAwoHH8",
 			expectedUri:   "",
 		},
+		{
+			name: "mute without target username test",
+			req: &pb.GenerateVivoxTokenRequest{
+				Type:        pb.GenerateVivoxTokenRequestType_mute,
+				Username:    "beef",
+				ChannelId:   "testchannel",
+				ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			},
+			wantErr: true,
+		},
+		{
+			name: "mute without channel id test",
+			req: &pb.GenerateVivoxTokenRequest{
+				Type:           pb.GenerateVivoxTokenRequestType_mute,
+				Username:       "beef",
+				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+				TargetUsername: "jerky",
+			},
+			wantErr: true,
+		},
 		{
 			// Test values taken from:
 			// https://docs.vivox.com/v5/general/unity/15_1_160000/en-us/access-token-guide/access-token-examples/example-mute-all-token.htm
@@ -253,10 +294,10 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionMute,
+				Vxa: vivox.ActionMute,
 				Vxi: 19283,
 				F:   "sip:.blindmelon-AppName-dev.beef.@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -276,10 +317,10 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_echo,
 				TargetUsername: "", // all users
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
-				Vxa: ActionMute,
+				Vxa: vivox.ActionMute,
 				Vxi: 825647,
 				F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
 				T:   "sip:confctl-g-blindmelon-AppName-dev.testchannel@tla.vivox.com",
@@ -299,7 +340,7 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 				ChannelType:    0,
 				TargetUsername: "jerky",
 			},
-			claims: &Claims{
+			claims: &vivox.Claims{
 				Iss: "blindmelon-AppName-dev",
 				Exp: 1600349400,
 				Vxa: "trxn",
@@ -330,7 +371,7 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 			tokenRepo := mocks.NewMockTokenRepository(ctrl)
 			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
 			configRepo := mocks.NewMockConfigRepository(ctrl)
-			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, tt.claims)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), tt.claims)
 
 			// when
 			res, err := service.GenerateVivoxToken(context.Background(), tt.req)
@@ -345,3 +386,1331 @@ func TestMyServiceServerImpl_GenerateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestMyServiceServerImpl_GenerateMuteToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type:           pb.GenerateVivoxTokenRequestType_mute,
+		Username:       "beef",
+		ChannelId:      "testchannel",
+		ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+		TargetUsername: "jerky",
+	})
+	require.NoError(t, err)
+
+	// the signed claims, not only the response fields
+	claims, err := vivox.Verify(res.AccessToken, testSigningKey, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, vivox.ActionMute, claims.Vxa)
+	require.Equal(t, "sip:."+testIssuer+".jerky.@"+testDomain, claims.Sub)
+	require.Equal(t, "sip:."+testIssuer+".beef.@"+testDomain, claims.F)
+	require.Equal(t, "sip:confctl-g-"+testIssuer+".testchannel@"+testDomain, claims.T)
+}
+
+func TestMyServiceServerImpl_GenerateTranscriptionToken(t *testing.T) {
+	req := &pb.GenerateVivoxTokenRequest{
+		Type:        pb.GenerateVivoxTokenRequestType_transcription,
+		Username:    "beef",
+		ChannelId:   "testchannel",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	}
+
+	tests := []struct {
+		name                    string
+		transcriptionNamespaces []string
+		wantCode                codes.Code
+	}{
+		{
+			name:                    "namespace not enabled",
+			transcriptionNamespaces: nil,
+			wantCode:                codes.PermissionDenied,
+		},
+		{
+			name:                    "other namespace enabled",
+			transcriptionNamespaces: []string{"othernamespace"},
+			wantCode:                codes.PermissionDenied,
+		},
+		{
+			name:                    "namespace enabled",
+			transcriptionNamespaces: []string{"othernamespace", testConfig().Namespace},
+			wantCode:                codes.OK,
+		},
+		{
+			name:                    "all namespaces enabled",
+			transcriptionNamespaces: []string{"*"},
+			wantCode:                codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config := testConfig()
+			config.Vivox.TranscriptionNamespaces = tt.transcriptionNamespaces
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+
+			// when
+			res, err := service.GenerateVivoxToken(context.Background(), req)
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.NotEmpty(t, res.AccessToken)
+				require.Equal(t, "sip:confctl-g-"+testIssuer+".testchannel@"+testDomain, res.Uri)
+			}
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GeneratePositionalToken(t *testing.T) {
+	validProps := func() *pb.GenerateVivoxTokenRequestChannelProperties {
+		return &pb.GenerateVivoxTokenRequestChannelProperties{
+			AudibleDistance:        50,
+			ConversationalDistance: 5,
+			FadeIntensity:          1.5,
+			FadeModel:              pb.GenerateVivoxTokenRequestFadeModel_linear_by_distance,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		channelType pb.GenerateVivoxTokenRequestChannelType
+		props       func() *pb.GenerateVivoxTokenRequestChannelProperties
+		wantCode    codes.Code
+		expectedUri string
+	}{
+		{
+			name:        "positional without properties",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props:       func() *pb.GenerateVivoxTokenRequestChannelProperties { return nil },
+			wantCode:    codes.OK,
+			expectedUri: "sip:confctl-d-" + testIssuer + ".match1@" + testDomain,
+		},
+		{
+			name:        "positional with properties",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props:       validProps,
+			wantCode:    codes.OK,
+			expectedUri: "sip:confctl-d-" + testIssuer + ".match1!p-50-5-1.500-2@" + testDomain,
+		},
+		{
+			name:        "properties on non positional channel",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			props:       validProps,
+			wantCode:    codes.InvalidArgument,
+		},
+		{
+			name:        "conversational distance not positive",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props: func() *pb.GenerateVivoxTokenRequestChannelProperties {
+				p := validProps()
+				p.ConversationalDistance = 0
+				return p
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "audible distance not greater than conversational distance",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props: func() *pb.GenerateVivoxTokenRequestChannelProperties {
+				p := validProps()
+				p.AudibleDistance = 5
+				return p
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "fade intensity out of range",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props: func() *pb.GenerateVivoxTokenRequestChannelProperties {
+				p := validProps()
+				p.FadeIntensity = 2.5
+				return p
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "unknown fade model",
+			channelType: pb.GenerateVivoxTokenRequestChannelType_positional,
+			props: func() *pb.GenerateVivoxTokenRequestChannelProperties {
+				p := validProps()
+				p.FadeModel = pb.GenerateVivoxTokenRequestFadeModel_generatevivoxtokenrequest_fademodel_unknown
+				return p
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+			// when
+			res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+				Type:              pb.GenerateVivoxTokenRequestType_join,
+				Username:          "beef",
+				ChannelId:         "match1",
+				ChannelType:       tt.channelType,
+				ChannelProperties: tt.props(),
+			})
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, tt.expectedUri, res.Uri)
+			}
+		})
+	}
+}
+
+func TestMyServiceServerImpl_VerifyToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	generated, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type:        pb.GenerateVivoxTokenRequestType_join,
+		Username:    "beef",
+		ChannelId:   "testchannel",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		accessToken string
+		wantCode    codes.Code
+		wantValid   bool
+		wantReason  pb.VerifyVivoxTokenFailureReason
+	}{
+		{
+			name:        "issued token",
+			accessToken: generated.AccessToken,
+			wantValid:   true,
+			wantReason:  pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_none,
+		},
+		{
+			name:        "tampered token",
+			accessToken: generated.AccessToken + "x",
+			wantReason:  pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_invalid_signature,
+		},
+		{
+			name:        "malformed token",
+			accessToken: "not-a-token",
+			wantReason:  pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_malformed,
+		},
+		{
+			name:        "empty token",
+			accessToken: "",
+			wantCode:    codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			res, err := service.VerifyVivoxToken(context.Background(), &pb.VerifyVivoxTokenRequest{AccessToken: tt.accessToken})
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+			require.Equal(t, tt.wantValid, res.Valid)
+			require.Equal(t, tt.wantReason, res.Reason)
+			if tt.wantReason != pb.VerifyVivoxTokenFailureReason_verifyvivoxtokenfailurereason_malformed {
+				require.Equal(t, vivox.ActionJoin, res.Claims.Vxa)
+				require.Equal(t, generated.Uri, res.Claims.T)
+			}
+		})
+	}
+}
+
+type fakeValidator struct {
+	granted map[string]bool
+}
+
+func (v *fakeValidator) Initialize(ctx ...context.Context) error {
+	return nil
+}
+
+// Validate grants the permissions listed with {namespace} in any namespace, and the others in their own namespace
+func (v *fakeValidator) Validate(token string, permission *iam.Permission, namespace *string, userId *string) error {
+	if permission == nil || v.granted[permission.Resource] {
+		return nil
+	}
+	if namespace != nil && v.granted[strings.ReplaceAll(permission.Resource, "{namespace}", *namespace)] {
+		return nil
+	}
+
+	return fmt.Errorf("insufficient permissions")
+}
+
+func TestMyServiceServerImpl_GenerateTokenSubjectBinding(t *testing.T) {
+	tests := []struct {
+		name     string
+		authInfo *common.AuthInfo
+		granted  map[string]bool
+		username string
+		wantCode codes.Code
+	}{
+		{
+			name:     "authorization disabled",
+			authInfo: nil,
+			username: "beef",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "own username",
+			authInfo: &common.AuthInfo{Token: "token", Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}}},
+			username: "beef",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "another username",
+			authInfo: &common.AuthInfo{Token: "token", Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}}},
+			username: "jerky",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "client token without admin permission",
+			authInfo: &common.AuthInfo{Token: "token", Claims: iam.JWTClaims{ClientID: "gameserver"}},
+			username: "jerky",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "another username with admin permission",
+			authInfo: &common.AuthInfo{Token: "token", Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}}},
+			granted:  map[string]bool{testConfig().Vivox.AdminPermissionResource: true},
+			username: "jerky",
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			original := common.Validator
+			common.Validator = &fakeValidator{granted: tt.granted}
+			defer func() { common.Validator = original }()
+
+			ctx := context.Background()
+			if tt.authInfo != nil {
+				ctx = common.ContextWithAuthInfo(ctx, tt.authInfo)
+			}
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+			// when
+			_, err := service.GenerateVivoxToken(ctx, &pb.GenerateVivoxTokenRequest{
+				Type:     pb.GenerateVivoxTokenRequestType_login,
+				Username: tt.username,
+			})
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokenSerialSource(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithSerialSource(vivox.NewMonotonicSerialSource(41)))
+
+	for _, wantSerial := range []int64{42, 43} {
+		// when
+		res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+			Type:     pb.GenerateVivoxTokenRequestType_login,
+			Username: "beef",
+		})
+
+		// then
+		require.NoError(t, err)
+		claims, err := vivox.Verify(res.AccessToken, testSigningKey, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, wantSerial, claims.Vxi)
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokenTenant(t *testing.T) {
+	tenants, err := NewTenantRegistry(
+		Tenant{Namespace: "game1", Issuer: "game1-app", Domain: "mt1.vivox.com", SigningKey: "game1-key"},
+		Tenant{Namespace: "game2", Issuer: "game2-app", Domain: "mt2.vivox.com", SigningKey: "game2-key", Protocol: "sips", ChannelPrefix: "conf"},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		tokenNs     string
+		granted     map[string]bool
+		reqNs       string
+		wantCode    codes.Code
+		wantKey     string
+		wantIssuer  string
+		expectedUri string
+	}{
+		{
+			name:        "namespace from path",
+			reqNs:       "game2",
+			wantCode:    codes.OK,
+			wantKey:     "game2-key",
+			wantIssuer:  "game2-app",
+			expectedUri: "sips:conf-g-game2-app.lobby@mt2.vivox.com",
+		},
+		{
+			name:        "namespace from token",
+			tokenNs:     "game1",
+			wantCode:    codes.OK,
+			wantKey:     "game1-key",
+			wantIssuer:  "game1-app",
+			expectedUri: "sip:confctl-g-game1-app.lobby@mt1.vivox.com",
+		},
+		{
+			name:     "unconfigured namespace",
+			reqNs:    "game3",
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "other namespace than the token",
+			tokenNs:  "game1",
+			reqNs:    "game2",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "other namespace with admin permission in own namespace",
+			tokenNs:  "game1",
+			granted:  map[string]bool{strings.ReplaceAll(testConfig().Vivox.AdminPermissionResource, "{namespace}", "game1"): true},
+			reqNs:    "game2",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:        "other namespace with admin permission in requested namespace",
+			tokenNs:     "game1",
+			granted:     map[string]bool{strings.ReplaceAll(testConfig().Vivox.AdminPermissionResource, "{namespace}", "game2"): true},
+			reqNs:       "game2",
+			wantCode:    codes.OK,
+			wantKey:     "game2-key",
+			wantIssuer:  "game2-app",
+			expectedUri: "sips:conf-g-game2-app.lobby@mt2.vivox.com",
+		},
+		{
+			name:        "other namespace with admin permission",
+			tokenNs:     "game1",
+			granted:     map[string]bool{testConfig().Vivox.AdminPermissionResource: true},
+			reqNs:       "game2",
+			wantCode:    codes.OK,
+			wantKey:     "game2-key",
+			wantIssuer:  "game2-app",
+			expectedUri: "sips:conf-g-game2-app.lobby@mt2.vivox.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			original := common.Validator
+			common.Validator = &fakeValidator{granted: tt.granted}
+			defer func() { common.Validator = original }()
+
+			ctx := context.Background()
+			if tt.tokenNs != "" {
+				ctx = common.ContextWithAuthInfo(ctx, &common.AuthInfo{
+					Token:  "token",
+					Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}, Namespace: tt.tokenNs},
+				})
+			}
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithTenantRegistry(tenants))
+
+			// when
+			res, err := service.GenerateVivoxToken(ctx, &pb.GenerateVivoxTokenRequest{
+				Type:        pb.GenerateVivoxTokenRequestType_join,
+				Username:    "beef",
+				ChannelId:   "lobby",
+				ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+				Namespace:   tt.reqNs,
+			})
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, tt.expectedUri, res.Uri)
+				claims, err := vivox.Verify(res.AccessToken, tt.wantKey, time.Now())
+				require.NoError(t, err)
+				require.Equal(t, tt.wantIssuer, claims.Iss)
+			}
+		})
+	}
+}
+
+func TestMyServiceServerImpl_SigningKeyRotation(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("active: v1\nkeys:\n  - version: v1\n    key: first\n"), 0o600))
+	keys, err := LoadKeyring(path)
+	require.NoError(t, err)
+	tenants := NewSingleTenantRegistry(Tenant{Issuer: testIssuer, Domain: testDomain, Keys: keys})
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithTenantRegistry(tenants))
+
+	login := &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef"}
+	previous, err := service.GenerateVivoxToken(context.Background(), login)
+	require.NoError(t, err)
+
+	// when
+	retireAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	require.NoError(t, os.WriteFile(path, []byte("active: v2\nkeys:\n  - version: v2\n    key: second\n  - version: v1\n    key: first\n    retireAt: "+retireAt+"\n"), 0o600))
+	_, err = keys.Reload()
+	require.NoError(t, err)
+	current, err := service.GenerateVivoxToken(context.Background(), login)
+	require.NoError(t, err)
+
+	// then
+	_, err = vivox.Verify(current.AccessToken, "second", time.Now())
+	require.NoError(t, err)
+	for _, token := range []string{previous.AccessToken, current.AccessToken} {
+		res, err := service.VerifyVivoxToken(context.Background(), &pb.VerifyVivoxTokenRequest{AccessToken: token})
+		require.NoError(t, err)
+		require.True(t, res.Valid, res.Message)
+	}
+
+	list, err := service.ListVivoxSigningKeys(context.Background(), &pb.ListVivoxSigningKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Keys, 2)
+	require.Equal(t, "v2", list.Keys[0].Version)
+	require.True(t, list.Keys[0].Active)
+	require.Equal(t, "v1", list.Keys[1].Version)
+	require.False(t, list.Keys[1].Active)
+	require.False(t, list.Keys[1].Retired)
+	require.NotZero(t, list.Keys[1].RetireAt)
+	require.NotContains(t, list.String(), "first")
+	require.NotContains(t, list.String(), "second")
+}
+
+func TestMyServiceServerImpl_GenerateTokenTTL(t *testing.T) {
+	tests := []struct {
+		name             string
+		outOfBounds      string
+		action           pb.GenerateVivoxTokenRequestType
+		expiresInSeconds int32
+		wantCode         codes.Code
+		wantTTL          int64
+	}{
+		{
+			name:     "login default",
+			action:   pb.GenerateVivoxTokenRequestType_login,
+			wantCode: codes.OK,
+			wantTTL:  600,
+		},
+		{
+			name:             "login within bounds",
+			action:           pb.GenerateVivoxTokenRequestType_login,
+			expiresInSeconds: 3600,
+			wantCode:         codes.OK,
+			wantTTL:          3600,
+		},
+		{
+			name:             "login above max rejected",
+			action:           pb.GenerateVivoxTokenRequestType_login,
+			expiresInSeconds: 7200,
+			wantCode:         codes.InvalidArgument,
+		},
+		{
+			name:             "login above max clamped",
+			outOfBounds:      common.TTLOutOfBoundsClamp,
+			action:           pb.GenerateVivoxTokenRequestType_login,
+			expiresInSeconds: 7200,
+			wantCode:         codes.OK,
+			wantTTL:          3600,
+		},
+		{
+			name:             "kick below min clamped",
+			outOfBounds:      common.TTLOutOfBoundsClamp,
+			action:           pb.GenerateVivoxTokenRequestType_kick,
+			expiresInSeconds: 1,
+			wantCode:         codes.OK,
+			wantTTL:          5,
+		},
+		{
+			name:     "join falls back to the default expiry",
+			action:   pb.GenerateVivoxTokenRequestType_join,
+			wantCode: codes.OK,
+			wantTTL:  90,
+		},
+		{
+			name:             "join above the default expiry rejected",
+			action:           pb.GenerateVivoxTokenRequestType_join,
+			expiresInSeconds: 91,
+			wantCode:         codes.InvalidArgument,
+		},
+		{
+			name:             "negative",
+			outOfBounds:      common.TTLOutOfBoundsClamp,
+			action:           pb.GenerateVivoxTokenRequestType_login,
+			expiresInSeconds: -1,
+			wantCode:         codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config := testConfig()
+			config.Vivox.TTLs = map[string]common.TTLPolicy{
+				"login": {Min: 60, Default: 600, Max: 3600},
+				"kick":  {Min: 5, Default: 10, Max: 30},
+			}
+			if tt.outOfBounds != "" {
+				config.Vivox.TTLOutOfBounds = tt.outOfBounds
+			}
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+
+			// when
+			before := time.Now().Unix()
+			res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+				Type:             tt.action,
+				Username:         "beef",
+				TargetUsername:   "jerky",
+				ChannelId:        "lobby",
+				ChannelType:      pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+				ExpiresInSeconds: tt.expiresInSeconds,
+			})
+			after := time.Now().Unix()
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.GreaterOrEqual(t, res.ExpiresAt, before+tt.wantTTL)
+				require.LessOrEqual(t, res.ExpiresAt, after+tt.wantTTL)
+				claims, err := vivox.Verify(res.AccessToken, testSigningKey, time.Now())
+				require.NoError(t, err)
+				require.Equal(t, res.ExpiresAt, claims.Exp)
+			}
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokenResponse(t *testing.T) {
+	userURI := func(username string) string {
+		return "sip:." + testIssuer + "." + username + ".@" + testDomain
+	}
+	channelURI := "sip:confctl-g-" + testIssuer + ".lobby@" + testDomain
+
+	tests := []struct {
+		name   string
+		action pb.GenerateVivoxTokenRequestType
+		want   *pb.GenerateVivoxTokenResponse
+	}{
+		{
+			name:   "login",
+			action: pb.GenerateVivoxTokenRequestType_login,
+			want:   &pb.GenerateVivoxTokenResponse{FromUri: userURI("beef"), Serial: 42, Action: vivox.ActionLogin},
+		},
+		{
+			name:   "join",
+			action: pb.GenerateVivoxTokenRequestType_join,
+			want:   &pb.GenerateVivoxTokenResponse{Uri: channelURI, FromUri: userURI("beef"), ToUri: channelURI, Serial: 42, Action: vivox.ActionJoin},
+		},
+		{
+			name:   "kick",
+			action: pb.GenerateVivoxTokenRequestType_kick,
+			want: &pb.GenerateVivoxTokenResponse{
+				Uri: channelURI, FromUri: userURI("beef"), ToUri: channelURI, SubUri: userURI("jerky"), Serial: 42, Action: vivox.ActionKick,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil,
+				WithSerialSource(vivox.NewMonotonicSerialSource(41)))
+
+			// when
+			res, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+				Type:           tt.action,
+				Username:       "beef",
+				TargetUsername: "jerky",
+				ChannelId:      "lobby",
+				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			})
+
+			// then
+			require.NoError(t, err)
+			require.NotEmpty(t, res.AccessToken)
+			require.NotZero(t, res.ExpiresAt)
+			tt.want.AccessToken = res.AccessToken
+			tt.want.ExpiresAt = res.ExpiresAt
+			require.Equal(t, tt.want.String(), res.String())
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokenInvalidID(t *testing.T) {
+	tests := []struct {
+		name       string
+		idEncoding string
+		req        *pb.GenerateVivoxTokenRequest
+		wantField  string
+		wantRule   string
+		wantFrom   string
+	}{
+		{
+			name:      "username with @",
+			req:       &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef@example.com"},
+			wantField: "username",
+			wantRule:  "must only contain ASCII letters, digits and =+-_.!~(), got '@'",
+		},
+		{
+			name: "target username with space",
+			req: &pb.GenerateVivoxTokenRequest{
+				Type: pb.GenerateVivoxTokenRequestType_mute, Username: "beef", TargetUsername: "jerky beef",
+				ChannelId: "lobby", ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			},
+			wantField: "target_username",
+			wantRule:  "got ' '",
+		},
+		{
+			name: "channel id as URI",
+			req: &pb.GenerateVivoxTokenRequest{
+				Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef",
+				ChannelId: "sip:confctl-g-demo.lobby@tla.vivox.com", ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			},
+			wantField: "channel_id",
+			wantRule:  "got ':'",
+		},
+		{
+			name:       "username encoded",
+			idEncoding: string(vivox.IDEncodingPercent),
+			req:        &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef@example.com"},
+			wantFrom:   "sip:.demo.beef%40example.com.@tla.vivox.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config := testConfig()
+			if tt.idEncoding != "" {
+				config.Vivox.IDEncoding = tt.idEncoding
+			}
+			tokenRepo := mocks.NewMockTokenRepository(ctrl)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+			configRepo := mocks.NewMockConfigRepository(ctrl)
+			service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, config, nil)
+
+			// when
+			res, err := service.GenerateVivoxToken(context.Background(), tt.req)
+
+			// then
+			if tt.wantField == "" {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantFrom, res.FromUri)
+
+				return
+			}
+			st := status.Convert(err)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.True(t, strings.HasPrefix(st.Message(), tt.wantField+" must"), st.Message())
+			require.Contains(t, st.Message(), tt.wantRule)
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Equal(t, tt.wantField, badRequest.FieldViolations[0].Field)
+			require.Contains(t, badRequest.FieldViolations[0].Description, tt.wantRule)
+		})
+	}
+}
+
+func TestMyServiceServerImpl_ResolveUri(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	props := &pb.GenerateVivoxTokenRequestChannelProperties{
+		AudibleDistance: 32, ConversationalDistance: 1, FadeIntensity: 1, FadeModel: pb.GenerateVivoxTokenRequestFadeModel_inverse_by_distance,
+	}
+	token, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type:              pb.GenerateVivoxTokenRequestType_join,
+		Username:          "beef",
+		ChannelId:         "lobby",
+		ChannelType:       pb.GenerateVivoxTokenRequestChannelType_positional,
+		ChannelProperties: props,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		uri      string
+		want     *pb.ResolveVivoxUriResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "user",
+			uri:  token.FromUri,
+			want: &pb.ResolveVivoxUriResponse{Kind: pb.VivoxUriKind_vivoxurikind_user, Namespace: "accelbyte", Issuer: testIssuer, UserId: "beef"},
+		},
+		{
+			name: "channel",
+			uri:  token.ToUri,
+			want: &pb.ResolveVivoxUriResponse{
+				Kind: pb.VivoxUriKind_vivoxurikind_channel, Namespace: "accelbyte", Issuer: testIssuer,
+				ChannelType: pb.GenerateVivoxTokenRequestChannelType_positional, ChannelId: "lobby", ChannelProperties: props,
+			},
+		},
+		{
+			name: "server",
+			uri:  "sip:" + testIssuer + "-service@" + testDomain,
+			want: &pb.ResolveVivoxUriResponse{Kind: pb.VivoxUriKind_vivoxurikind_server, Namespace: "accelbyte", Issuer: testIssuer},
+		},
+		{
+			name:     "other issuer",
+			uri:      "sip:.other.beef.@" + testDomain,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "malformed",
+			uri:      "beef",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			res, err := service.ResolveVivoxUri(context.Background(), &pb.ResolveVivoxUriRequest{Uri: tt.uri})
+
+			// then
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, tt.want.String(), res.String())
+			}
+		})
+	}
+}
+
+func TestMyServiceServerImpl_GenerateTokens(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	original := common.Validator
+	common.Validator = &fakeValidator{granted: map[string]bool{"NAMESPACE:{namespace}:VIVOX:TOKEN": true}}
+	defer func() { common.Validator = original }()
+
+	ctx := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{
+		Token:  "token",
+		Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}},
+	})
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	// when
+	res, err := service.GenerateVivoxTokens(ctx, &pb.GenerateVivoxTokensRequest{
+		Tokens: []*pb.GenerateVivoxTokenRequest{
+			{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef"},
+			{Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef", ChannelId: "team1", ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional},
+			{Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef", ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional},
+			{Type: pb.GenerateVivoxTokenRequestType_kick, Username: "beef", TargetUsername: "jerky", ChannelId: "team1"},
+			{Type: pb.GenerateVivoxTokenRequestType_join, Username: "jerky", ChannelId: "team1", ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional},
+			{Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef", ChannelId: "echo", ChannelType: pb.GenerateVivoxTokenRequestChannelType_echo},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, res.Results, 6)
+	wantCodes := []codes.Code{codes.OK, codes.OK, codes.InvalidArgument, codes.PermissionDenied, codes.PermissionDenied, codes.OK}
+	for i, result := range res.Results {
+		if wantCodes[i] == codes.OK {
+			require.Nil(t, result.Error, "token %d", i)
+			require.NotEmpty(t, result.Token.AccessToken, "token %d", i)
+		} else {
+			require.Nil(t, result.Token, "token %d", i)
+			require.Equal(t, int32(wantCodes[i]), result.Error.Code, "token %d", i)
+			require.NotEmpty(t, result.Error.Message, "token %d", i)
+		}
+	}
+	require.NotEqual(t, res.Results[0].Token.Serial, res.Results[1].Token.Serial)
+}
+
+func TestMyServiceServerImpl_GenerateTokensKeepsRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	item := &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef"}
+	res, err := service.GenerateVivoxTokens(context.Background(), &pb.GenerateVivoxTokensRequest{
+		Namespace: testConfig().Namespace,
+		Tokens:    []*pb.GenerateVivoxTokenRequest{item},
+	})
+
+	require.NoError(t, err)
+	require.Nil(t, res.Results[0].Error)
+	require.Empty(t, item.Namespace, "the namespace of the batch is not written into its items")
+}
+
+func TestMyServiceServerImpl_GenerateTokensLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	tooMany := make([]*pb.GenerateVivoxTokenRequest, maxBatchTokens+1)
+	for i := range tooMany {
+		tooMany[i] = &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef"}
+	}
+
+	for _, tokens := range [][]*pb.GenerateVivoxTokenRequest{nil, tooMany} {
+		_, err := service.GenerateVivoxTokens(context.Background(), &pb.GenerateVivoxTokensRequest{Tokens: tokens})
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+type fakeRefreshStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []*pb.GenerateVivoxTokenResponse
+	onSend func(count int)
+}
+
+func (s *fakeRefreshStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeRefreshStream) Send(res *pb.GenerateVivoxTokenResponse) error {
+	s.sent = append(s.sent, res)
+	s.onSend(len(s.sent))
+
+	return nil
+}
+
+func TestMyServiceServerImpl_RefreshToken(t *testing.T) {
+	req := &pb.GenerateVivoxTokenRequest{
+		Type:        pb.GenerateVivoxTokenRequestType_join,
+		Username:    "beef",
+		ChannelId:   "lobby",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	}
+
+	t.Run("refreshes until the client cancels", func(t *testing.T) {
+		// given
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := mocks.NewMockTokenRepository(ctrl)
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		configRepo := mocks.NewMockConfigRepository(ctrl)
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var delays []time.Duration
+		service.after = func(d time.Duration) <-chan time.Time {
+			delays = append(delays, d)
+			ready := make(chan time.Time, 1)
+			if ctx.Err() == nil {
+				ready <- time.Now()
+			}
+
+			return ready
+		}
+		stream := &fakeRefreshStream{ctx: ctx, onSend: func(count int) {
+			if count == 3 {
+				cancel()
+			}
+		}}
+
+		// when
+		err := service.RefreshVivoxToken(req, stream)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.sent, 3)
+		require.NotEqual(t, stream.sent[0].Serial, stream.sent[1].Serial)
+		require.Len(t, delays, 3)
+		for _, delay := range delays {
+			// 90 seconds default expiry minus the 10 seconds refresh lead
+			require.InDelta(t, float64(80*time.Second), float64(delay), float64(time.Second))
+		}
+	})
+
+	t.Run("closes when the caller loses permission", func(t *testing.T) {
+		// given
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		validator := &fakeValidator{granted: map[string]bool{"NAMESPACE:{namespace}:VIVOX:TOKEN": true}}
+		original := common.Validator
+		common.Validator = validator
+		defer func() { common.Validator = original }()
+
+		tokenRepo := mocks.NewMockTokenRepository(ctrl)
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		configRepo := mocks.NewMockConfigRepository(ctrl)
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+		service.after = func(time.Duration) <-chan time.Time {
+			ready := make(chan time.Time, 1)
+			ready <- time.Now()
+
+			return ready
+		}
+
+		ctx := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{
+			Token:  "token",
+			Claims: iam.JWTClaims{Claims: jwt.Claims{Subject: "beef"}},
+		})
+		stream := &fakeRefreshStream{ctx: ctx, onSend: func(count int) {
+			if count == 2 {
+				delete(validator.granted, "NAMESPACE:{namespace}:VIVOX:TOKEN")
+			}
+		}}
+
+		// when
+		err := service.RefreshVivoxToken(req, stream)
+
+		// then
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Len(t, stream.sent, 2)
+	})
+
+	t.Run("charges each refreshed token", func(t *testing.T) {
+		// given
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		limiter := common.NewRateLimiter(map[string]map[string]common.RateLimit{
+			"join": {common.RateLimitScopeNamespace: {PerMinute: 1, Burst: 2}},
+		})
+		tokenRepo := mocks.NewMockTokenRepository(ctrl)
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		configRepo := mocks.NewMockConfigRepository(ctrl)
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithRateLimiter(limiter))
+		service.after = func(time.Duration) <-chan time.Time {
+			ready := make(chan time.Time, 1)
+			ready <- time.Now()
+
+			return ready
+		}
+		stream := &fakeRefreshStream{ctx: context.Background(), onSend: func(int) {}}
+
+		// when
+		err := service.RefreshVivoxToken(req, stream)
+
+		// then the first token is charged by the interceptor, the next two take the burst
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Len(t, stream.sent, 3)
+	})
+
+	t.Run("rejects the tokens of other actions", func(t *testing.T) {
+		// given
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := mocks.NewMockTokenRepository(ctrl)
+		refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+		configRepo := mocks.NewMockConfigRepository(ctrl)
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+		for _, action := range []pb.GenerateVivoxTokenRequestType{
+			pb.GenerateVivoxTokenRequestType_kick,
+			pb.GenerateVivoxTokenRequestType_mute,
+			pb.GenerateVivoxTokenRequestType_transcription,
+		} {
+			stream := &fakeRefreshStream{ctx: context.Background()}
+
+			// when
+			err := service.RefreshVivoxToken(&pb.GenerateVivoxTokenRequest{
+				Type:           action,
+				Username:       "beef",
+				TargetUsername: "jerky",
+				ChannelId:      "lobby",
+				ChannelType:    pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+			}, stream)
+
+			// then
+			require.Equal(t, codes.InvalidArgument, status.Code(err), action.String())
+			require.Empty(t, stream.sent)
+		}
+	})
+}
+
+// failingSink rejects every entry, like a full disk
+type failingSink struct{}
+
+func (failingSink) Write(audit.Entry) error     { return fmt.Errorf("no space left on device") }
+func (failingSink) Last() (*audit.Entry, error) { return nil, nil }
+func (failingSink) Close() error                { return nil }
+
+func TestMyServiceServerImpl_GenerateTokenAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+
+	ctx := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{
+		Token:  "token",
+		Claims: iam.JWTClaims{ClientID: "game-server", Claims: jwt.Claims{Subject: "moderator"}},
+	})
+	kick := &pb.GenerateVivoxTokenRequest{
+		Type:           pb.GenerateVivoxTokenRequestType_kick,
+		Username:       "moderator",
+		TargetUsername: "griefer",
+		ChannelId:      "lobby",
+	}
+
+	t.Run("records audited actions", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		sink, err := audit.NewFileSink(path, 1<<20, 1)
+		require.NoError(t, err)
+		logger, err := audit.NewLogger(sink, []string{"kick"})
+		require.NoError(t, err)
+		defer logger.Close()
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithAuditLogger(logger))
+
+		res, err := service.GenerateVivoxToken(ctx, kick)
+		require.NoError(t, err)
+		_, err = service.GenerateVivoxToken(ctx, &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login, Username: "moderator"})
+		require.NoError(t, err)
+
+		report, err := audit.VerifyFiles(nil, path)
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Entries)
+		entry := report.Last
+		require.Equal(t, "kick", entry.Action)
+		require.Equal(t, "accelbyte", entry.Namespace)
+		require.Equal(t, "moderator", entry.CallerUserID)
+		require.Equal(t, "game-server", entry.CallerClientID)
+		require.Equal(t, "griefer", entry.TargetUserID)
+		require.Equal(t, "lobby", entry.ChannelID)
+		require.Equal(t, res.ToUri, entry.ToURI)
+		require.Equal(t, res.Serial, entry.Serial)
+		require.Equal(t, res.ExpiresAt, entry.ExpiresAt)
+	})
+
+	t.Run("withholds tokens that cannot be recorded", func(t *testing.T) {
+		logger, err := audit.NewLogger(failingSink{}, []string{"kick"})
+		require.NoError(t, err)
+		service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil, WithAuditLogger(logger))
+
+		res, err := service.GenerateVivoxToken(ctx, kick)
+
+		require.Nil(t, res)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestMyServiceServerImpl_GenerateTokenMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	issued := func(action, channelType, namespace string) float64 {
+		return testutil.ToFloat64(common.TokensIssued.WithLabelValues(action, channelType, namespace))
+	}
+	failed := func(reason string) float64 {
+		return testutil.ToFloat64(common.ValidationFailures.WithLabelValues(reason))
+	}
+	joins, logins := issued("join", "nonpositional", "accelbyte"), issued("login", "none", "other")
+	invalidRequests, invalidChannels := failed("invalid_request"), failed("invalid_channel_id")
+
+	_, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef", ChannelId: "team1",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	})
+	require.NoError(t, err)
+	// namespaces without their own tenant share a label
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_login, Username: "beef", Namespace: "unknown",
+	})
+	require.NoError(t, err)
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{Type: pb.GenerateVivoxTokenRequestType_login})
+	require.Error(t, err)
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_join, Username: "beef", ChannelId: "team 1",
+		ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+	})
+	require.Error(t, err)
+
+	require.Equal(t, joins+1, issued("join", "nonpositional", "accelbyte"))
+	require.Equal(t, logins+1, issued("login", "none", "other"))
+	require.Equal(t, invalidRequests+1, failed("invalid_request"))
+	require.Equal(t, invalidChannels+1, failed("invalid_channel_id"))
+	require.Positive(t, testutil.CollectAndCount(common.SigningDuration))
+}
+
+func TestMyServiceServerImpl_VoiceBans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenRepo := mocks.NewMockTokenRepository(ctrl)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(ctrl)
+	configRepo := mocks.NewMockConfigRepository(ctrl)
+	service := NewMyServiceServer(tokenRepo, configRepo, refreshRepo, testConfig(), nil)
+
+	admin := common.ContextWithAuthInfo(context.Background(), &common.AuthInfo{
+		Token:  "token",
+		Claims: iam.JWTClaims{Namespace: "accelbyte", Claims: jwt.Claims{Subject: "moderator"}},
+	})
+	join := func(username, channelID string) error {
+		_, err := service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+			Type: pb.GenerateVivoxTokenRequestType_join, Username: username, ChannelId: channelID,
+			ChannelType: pb.GenerateVivoxTokenRequestChannelType_nonpositional,
+		})
+
+		return err
+	}
+
+	channelBan, err := service.CreateVivoxBan(admin, &pb.CreateVivoxBanRequest{
+		UserId: "griefer", Scope: pb.VivoxBanScope_channel_ban, ChannelId: "lobby", Reason: "spam", DurationSeconds: 600,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "accelbyte", channelBan.Ban.Namespace)
+	require.Equal(t, "moderator", channelBan.Ban.CreatedBy)
+	require.NotEmpty(t, channelBan.Ban.BanId)
+	require.InDelta(t, time.Now().Add(10*time.Minute).Unix(), channelBan.Ban.ExpiresAt, 5)
+
+	// a channel ban only keeps the user out of that channel
+	require.NoError(t, join("griefer", "team1"))
+	require.NoError(t, join("player", "lobby"))
+	err = join("griefer", "lobby")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	until := time.Unix(channelBan.Ban.ExpiresAt, 0).UTC().Format(time.RFC3339)
+	require.Equal(t, "user griefer is banned from channel lobby until "+until+": spam", status.Convert(err).Message())
+	errorInfo, ok := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, channelBan.Ban.BanId, errorInfo.Metadata["banId"])
+
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	globalBan, err := service.CreateVivoxBan(admin, &pb.CreateVivoxBanRequest{
+		UserId: "griefer", Scope: pb.VivoxBanScope_global_ban, ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	// the global ban ends last, and also applies to logins
+	_, err = service.GenerateVivoxToken(context.Background(), &pb.GenerateVivoxTokenRequest{
+		Type: pb.GenerateVivoxTokenRequestType_login, Username: "griefer",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = join("griefer", "lobby")
+	require.Contains(t, status.Convert(err).Message(), "banned from voice until "+time.Unix(expiresAt, 0).UTC().Format(time.RFC3339))
+
+	list, err := service.ListVivoxBans(admin, &pb.ListVivoxBansRequest{UserId: "griefer"})
+	require.NoError(t, err)
+	require.Len(t, list.Bans, 2)
+	list, err = service.ListVivoxBans(admin, &pb.ListVivoxBansRequest{UserId: "player"})
+	require.NoError(t, err)
+	require.Empty(t, list.Bans)
+
+	lifted, err := service.LiftVivoxBan(admin, &pb.LiftVivoxBanRequest{BanId: globalBan.Ban.BanId})
+	require.NoError(t, err)
+	require.Equal(t, pb.VivoxBanScope_global_ban, lifted.Ban.Scope)
+	require.NoError(t, join("griefer", "team1"))
+	_, err = service.LiftVivoxBan(admin, &pb.LiftVivoxBanRequest{BanId: globalBan.Ban.BanId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	invalid := []*pb.CreateVivoxBanRequest{
+		{Scope: pb.VivoxBanScope_global_ban, DurationSeconds: 60},
+		{UserId: "griefer", DurationSeconds: 60},
+		{UserId: "griefer", Scope: pb.VivoxBanScope_channel_ban, DurationSeconds: 60},
+		{UserId: "griefer", Scope: pb.VivoxBanScope_global_ban, ChannelId: "lobby", DurationSeconds: 60},
+		{UserId: "griefer", Scope: pb.VivoxBanScope_global_ban},
+		{UserId: "griefer", Scope: pb.VivoxBanScope_global_ban, ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+		{UserId: "griefer", Scope: pb.VivoxBanScope_global_ban, ExpiresAt: expiresAt, DurationSeconds: 60},
+	}
+	for _, req := range invalid {
+		_, err = service.CreateVivoxBan(admin, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
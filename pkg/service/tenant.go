@@ -0,0 +1,188 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// version reported for a signingKey configured without a key file
+const staticKeyVersion = "static"
+
+// Tenant holds the credentials of the Vivox application serving a namespace
+type Tenant struct {
+	Namespace      string `yaml:"namespace"`
+	Issuer         string `yaml:"issuer"`
+	Domain         string `yaml:"domain"`
+	SigningKey     string `yaml:"signingKey"`
+	SigningKeyFile string `yaml:"signingKeyFile"` // versioned keys, replaces SigningKey when set
+	Protocol       string `yaml:"protocol"`
+	ChannelPrefix  string `yaml:"channelPrefix"`
+
+	Keys *Keyring `yaml:"-"`
+}
+
+// signingKey returns the key tokens are signed with
+func (t Tenant) signingKey() string {
+	if t.Keys != nil {
+		return t.Keys.SigningKey()
+	}
+
+	return t.SigningKey
+}
+
+// verify checks token against the active key and each previous key not yet retired
+func (t Tenant) verify(token string, now time.Time) (*vivox.Claims, error) {
+	keys := []string{t.SigningKey}
+	if t.Keys != nil {
+		keys = t.Keys.VerificationKeys(now)
+	}
+
+	var claims *vivox.Claims
+	err := errors.WithStack(vivox.ErrTokenSignatureInvalid)
+	for _, key := range keys {
+		claims, err = vivox.Verify(token, key, now)
+		if !errors.Is(err, vivox.ErrTokenSignatureInvalid) {
+			break
+		}
+	}
+
+	return claims, err
+}
+
+// issuer returns the Issuer signing tokens with the active key of the tenant
+func (t Tenant) issuer(opts ...vivox.IssuerOption) *vivox.Issuer {
+	opts = append([]vivox.IssuerOption{vivox.WithProtocol(t.Protocol), vivox.WithChannelPrefix(t.ChannelPrefix)}, opts...)
+
+	return vivox.NewIssuer(t.Issuer, t.Domain, t.signingKey(), opts...)
+}
+
+// ErrTenantNotFound is returned when no tenant is configured for a namespace
+var ErrTenantNotFound = errors.New("vivox tenant not found")
+
+// TenantRegistry maps AccelByte namespaces to their Vivox tenants
+type TenantRegistry struct {
+	tenants map[string]Tenant
+	// serves every namespace when the registry is not loaded from a file
+	fallback *Tenant
+}
+
+// NewSingleTenantRegistry returns a registry serving tenant for every namespace
+func NewSingleTenantRegistry(tenant Tenant) *TenantRegistry {
+	tenant = withTenantDefaults(tenant)
+
+	return &TenantRegistry{fallback: &tenant}
+}
+
+// NewTenantRegistry returns a registry serving only the given tenants, keyed by their namespace
+func NewTenantRegistry(tenants ...Tenant) (*TenantRegistry, error) {
+	r := &TenantRegistry{tenants: make(map[string]Tenant, len(tenants))}
+	for i, tenant := range tenants {
+		if tenant.Namespace == "" {
+			return nil, fmt.Errorf("tenant %d: namespace is required", i)
+		}
+		if tenant.Issuer == "" || (tenant.SigningKey == "" && tenant.SigningKeyFile == "") {
+			return nil, fmt.Errorf("tenant %s: issuer and signingKey or signingKeyFile are required", tenant.Namespace)
+		}
+		if tenant.Domain == "" {
+			return nil, fmt.Errorf("tenant %s: domain is required", tenant.Namespace)
+		}
+		if tenant.SigningKeyFile != "" {
+			keys, err := LoadKeyring(tenant.SigningKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("tenant %s: %w", tenant.Namespace, err)
+			}
+			tenant.Keys = keys
+		}
+		if _, exists := r.tenants[tenant.Namespace]; exists {
+			return nil, fmt.Errorf("tenant %s: namespace is configured more than once", tenant.Namespace)
+		}
+		r.tenants[tenant.Namespace] = withTenantDefaults(tenant)
+	}
+
+	return r, nil
+}
+
+// LoadTenantRegistry reads a YAML file with a top-level "tenants" list
+func LoadTenantRegistry(path string) (*TenantRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read tenant file")
+	}
+
+	var file struct {
+		Tenants []Tenant `yaml:"tenants"`
+	}
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "parse tenant file")
+	}
+
+	return NewTenantRegistry(file.Tenants...)
+}
+
+// Watch reloads the signing key files of all tenants every period until ctx is done
+func (r *TenantRegistry) Watch(ctx context.Context, period time.Duration) {
+	for _, tenant := range r.all() {
+		if tenant.Keys != nil {
+			go tenant.Keys.Watch(ctx, period)
+		}
+	}
+}
+
+func (r *TenantRegistry) all() []Tenant {
+	tenants := make([]Tenant, 0, len(r.tenants)+1)
+	for _, tenant := range r.tenants {
+		tenants = append(tenants, tenant)
+	}
+	if r.fallback != nil {
+		tenants = append(tenants, *r.fallback)
+	}
+
+	return tenants
+}
+
+// Configured reports whether ns has its own tenant, rather than the fallback tenant
+func (r *TenantRegistry) Configured(ns string) bool {
+	_, found := r.tenants[ns]
+
+	return found
+}
+
+// Lookup returns the tenant configured for ns
+func (r *TenantRegistry) Lookup(ns string) (Tenant, error) {
+	if tenant, found := r.tenants[ns]; found {
+		return tenant, nil
+	}
+	if r.fallback != nil {
+		tenant := *r.fallback
+		tenant.Namespace = ns
+
+		return tenant, nil
+	}
+
+	return Tenant{}, errors.Wrapf(ErrTenantNotFound, "namespace %s", ns)
+}
+
+func withTenantDefaults(tenant Tenant) Tenant {
+	if strings.TrimSpace(tenant.Protocol) == "" {
+		tenant.Protocol = vivox.DefaultProtocol
+	}
+	if strings.TrimSpace(tenant.ChannelPrefix) == "" {
+		tenant.ChannelPrefix = vivox.DefaultChannelPrefix
+	}
+	if tenant.Keys == nil {
+		tenant.Keys = NewStaticKeyring(staticKeyVersion, tenant.SigningKey)
+	}
+
+	return tenant
+}
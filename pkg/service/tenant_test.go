@@ -0,0 +1,101 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"extend-rtu-vivox-authorization-service/pkg/vivox"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTenantRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tenants.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+tenants:
+  - namespace: game1
+    issuer: game1-app
+    domain: mt1.vivox.com
+    signingKey: game1-key
+  - namespace: game2
+    issuer: game2-app
+    domain: mt2.vivox.com
+    signingKey: game2-key
+    protocol: sips
+    channelPrefix: conf
+`), 0o600))
+
+	registry, err := LoadTenantRegistry(path)
+	require.NoError(t, err)
+
+	game1, err := registry.Lookup("game1")
+	require.NoError(t, err)
+	require.Equal(t, "game1", game1.Namespace)
+	require.Equal(t, "game1-app", game1.Issuer)
+	require.Equal(t, "mt1.vivox.com", game1.Domain)
+	require.Equal(t, "game1-key", game1.signingKey())
+	require.Equal(t, vivox.DefaultProtocol, game1.Protocol)
+	require.Equal(t, vivox.DefaultChannelPrefix, game1.ChannelPrefix)
+
+	game2, err := registry.Lookup("game2")
+	require.NoError(t, err)
+	require.Equal(t, "sips", game2.Protocol)
+	require.Equal(t, "conf", game2.ChannelPrefix)
+
+	_, err = registry.Lookup("game3")
+	require.True(t, errors.Is(err, ErrTenantNotFound))
+}
+
+func TestNewTenantRegistryInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		tenants []Tenant
+		wantErr string
+	}{
+		{
+			name:    "missing namespace",
+			tenants: []Tenant{{Issuer: "app", Domain: "tla.vivox.com", SigningKey: "key"}},
+			wantErr: "tenant 0: namespace is required",
+		},
+		{
+			name:    "missing signing key",
+			tenants: []Tenant{{Namespace: "game1", Issuer: "app", Domain: "tla.vivox.com"}},
+			wantErr: "tenant game1: issuer and signingKey or signingKeyFile are required",
+		},
+		{
+			name:    "missing domain",
+			tenants: []Tenant{{Namespace: "game1", Issuer: "app", SigningKey: "key"}},
+			wantErr: "tenant game1: domain is required",
+		},
+		{
+			name: "duplicate namespace",
+			tenants: []Tenant{
+				{Namespace: "game1", Issuer: "app", Domain: "tla.vivox.com", SigningKey: "key"},
+				{Namespace: "game1", Issuer: "app", Domain: "tla.vivox.com", SigningKey: "key"},
+			},
+			wantErr: "tenant game1: namespace is configured more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTenantRegistry(tt.tenants...)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSingleTenantRegistry(t *testing.T) {
+	registry := NewSingleTenantRegistry(Tenant{Issuer: "app", Domain: "tla.vivox.com", SigningKey: "key"})
+
+	tenant, err := registry.Lookup("anything")
+	require.NoError(t, err)
+	require.Equal(t, "anything", tenant.Namespace)
+	require.Equal(t, "app", tenant.Issuer)
+	require.Equal(t, vivox.DefaultProtocol, tenant.Protocol)
+}
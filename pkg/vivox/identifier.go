@@ -0,0 +1,152 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxUserNameLength limits the ".issuer.userID." part of user URIs
+	MaxUserNameLength = 63
+	// MaxChannelNameLength limits the "prefix-t-issuer.channelID" part of channel URIs, including positional properties
+	MaxChannelNameLength = 200
+
+	// characters Vivox accepts in user and channel names besides ASCII letters, digits and '%',
+	// which is kept as the escape character of IDEncodingPercent
+	allowedSymbols = "=+-_.!~()"
+	// hashed IDs are marked with this prefix followed by the first hashLength hex digits of their SHA-256
+	hashPrefix = "h-"
+	hashLength = 32
+)
+
+// Fields of an IDError
+const (
+	FieldUserID       = "user_id"
+	FieldTargetUserID = "target_user_id"
+	FieldChannelID    = "channel_id"
+)
+
+// IDError reports a user or channel ID that cannot be used in a Vivox URI
+type IDError struct {
+	Field string
+	Rule  string
+}
+
+func (e *IDError) Error() string {
+	return e.Field + " " + e.Rule
+}
+
+// IDEncoding selects how IDs that are not Vivox-safe are handled
+type IDEncoding string
+
+const (
+	// IDEncodingNone rejects IDs that are not Vivox-safe
+	IDEncodingNone IDEncoding = "none"
+	// IDEncodingPercent percent-encodes the UTF-8 bytes of the characters Vivox does not accept. The encoding is reversible.
+	IDEncodingPercent IDEncoding = "percent"
+	// IDEncodingHash replaces IDs that are not Vivox-safe or too long by a hash of the ID. The encoding is not reversible.
+	IDEncodingHash IDEncoding = "hash"
+)
+
+// IDEncodings lists the supported ID encodings
+var IDEncodings = []IDEncoding{IDEncodingNone, IDEncodingPercent, IDEncodingHash}
+
+// encodeID returns the ID as used in a Vivox name, with maxLength characters left for it in that name
+func (e IDEncoding) encodeID(field, id string, maxLength int, reserved string) (string, error) {
+	if id == "" {
+		return "", &IDError{Field: field, Rule: "is required"}
+	}
+
+	rule := checkID(id, reserved)
+	if rule == "" && len(id) > maxLength {
+		rule = fmt.Sprintf("must not be longer than %d characters", maxLength)
+	}
+	if rule == "" {
+		return id, nil
+	}
+
+	encoded := id
+	switch e {
+	case IDEncodingPercent:
+		encoded = percentEncode(id, reserved)
+	case IDEncodingHash:
+		sum := sha256.Sum256([]byte(id))
+		encoded = hashPrefix + hex.EncodeToString(sum[:])[:hashLength]
+	default:
+		return "", &IDError{Field: field, Rule: rule}
+	}
+	if len(encoded) > maxLength {
+		return "", &IDError{Field: field, Rule: fmt.Sprintf("must not be longer than %d characters after %s encoding", maxLength, e)}
+	}
+
+	return encoded, nil
+}
+
+// decodeID is the inverse of encodeID when the encoding is reversible, hashed IDs are returned as they are
+func (e IDEncoding) decodeID(field, id string) (string, error) {
+	if e != IDEncodingPercent {
+		return id, nil
+	}
+
+	decoded, err := url.PathUnescape(id)
+	if err != nil {
+		return "", &IDError{Field: field, Rule: "is not percent encoded"}
+	}
+
+	return decoded, nil
+}
+
+// checkID returns the rule the ID breaks, if any
+func checkID(id, reserved string) string {
+	for _, r := range id {
+		if !isAllowed(r, reserved) {
+			symbols := strings.Map(func(s rune) rune {
+				if strings.ContainsRune(reserved, s) {
+					return -1
+				}
+
+				return s
+			}, allowedSymbols)
+
+			return fmt.Sprintf("must only contain ASCII letters, digits and %s, got %q", symbols, r)
+		}
+	}
+	// the dots around user IDs and between issuer and channel ID delimit the ID
+	if strings.HasPrefix(id, ".") || strings.HasSuffix(id, ".") {
+		return "must not start or end with '.'"
+	}
+
+	return ""
+}
+
+func isAllowed(r rune, reserved string) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	default:
+		return strings.ContainsRune(allowedSymbols, r) && !strings.ContainsRune(reserved, r)
+	}
+}
+
+func percentEncode(id, reserved string) string {
+	var b strings.Builder
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		edge := c == '.' && (i == 0 || i == len(id)-1)
+		if c < utf8.RuneSelf && isAllowed(rune(c), reserved) && !edge {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuerRejectsUnsafeIDs(t *testing.T) {
+	issuer := NewIssuer("demo", "tla.vivox.com", "secret!")
+	lobby := Channel{Type: ChannelNonPositional, ID: "lobby"}
+
+	tests := []struct {
+		name      string
+		issue     func() (Token, error)
+		wantField string
+		wantRule  string
+	}{
+		{
+			name:      "empty user",
+			issue:     func() (Token, error) { return issuer.Login("", time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  "is required",
+		},
+		{
+			name:      "user with @",
+			issue:     func() (Token, error) { return issuer.Login("beef@example.com", time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  `got '@'`,
+		},
+		{
+			name:      "user with space",
+			issue:     func() (Token, error) { return issuer.Login("beef jerky", time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  `got ' '`,
+		},
+		{
+			name:      "user with unicode",
+			issue:     func() (Token, error) { return issuer.Login("bëef", time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  `got 'ë'`,
+		},
+		{
+			name:      "user ending with a dot",
+			issue:     func() (Token, error) { return issuer.Login("beef.", time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  "must not start or end with '.'",
+		},
+		{
+			name:      "user too long",
+			issue:     func() (Token, error) { return issuer.Login(strings.Repeat("a", 57), time.Minute) },
+			wantField: FieldUserID,
+			wantRule:  "must not be longer than 56 characters",
+		},
+		{
+			name:      "target with colon",
+			issue:     func() (Token, error) { return issuer.Mute("beef", "sip:jerky", lobby, time.Minute) },
+			wantField: FieldTargetUserID,
+			wantRule:  `got ':'`,
+		},
+		{
+			name: "channel given as URI",
+			issue: func() (Token, error) {
+				return issuer.Join("beef", Channel{Type: ChannelNonPositional, ID: "sip:confctl-g-demo.lobby@tla.vivox.com"}, time.Minute)
+			},
+			wantField: FieldChannelID,
+			wantRule:  `got ':'`,
+		},
+		{
+			name: "channel with property delimiter",
+			issue: func() (Token, error) {
+				return issuer.Join("beef", Channel{Type: ChannelNonPositional, ID: "lobby!p-1-1-1.000-1"}, time.Minute)
+			},
+			wantField: FieldChannelID,
+			wantRule:  "must only contain ASCII letters, digits and =+-_.~(), got '!'",
+		},
+		{
+			name: "channel too long",
+			issue: func() (Token, error) {
+				return issuer.Join("beef", Channel{Type: ChannelNonPositional, ID: strings.Repeat("a", 186)}, time.Minute)
+			},
+			wantField: FieldChannelID,
+			wantRule:  "must not be longer than 185 characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.issue()
+
+			var idErr *IDError
+			require.ErrorAs(t, err, &idErr)
+			assert.Equal(t, tt.wantField, idErr.Field)
+			assert.Contains(t, idErr.Rule, tt.wantRule)
+		})
+	}
+}
+
+func TestIssuerAcceptsSafeIDs(t *testing.T) {
+	issuer := NewIssuer("demo", "tla.vivox.com", "secret!")
+
+	token, err := issuer.Join("baldeagle.1973", Channel{Type: ChannelNonPositional, ID: strings.Repeat("a", 185)}, time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sip:.demo.baldeagle.1973.@tla.vivox.com", token.Claims.F)
+
+	token, err = issuer.Login("Player_(1)=+-~", time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sip:.demo.Player_(1)=+-~.@tla.vivox.com", token.Claims.F)
+}
+
+func TestIssuerIDEncoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding IDEncoding
+		userID   string
+		channel  string
+		wantUser string
+		wantErr  string
+	}{
+		{
+			name:     "percent encodes unsafe characters",
+			encoding: IDEncodingPercent,
+			userID:   "bëef@example.com",
+			channel:  "party 1",
+			wantUser: "sip:.demo.b%C3%ABef%40example.com.@tla.vivox.com",
+		},
+		{
+			name:     "percent encodes the escape character and edge dots",
+			encoding: IDEncodingPercent,
+			userID:   ".100%",
+			channel:  "lobby",
+			wantUser: "sip:.demo.%2E100%25.@tla.vivox.com",
+		},
+		{
+			name:     "percent keeps safe IDs",
+			encoding: IDEncodingPercent,
+			userID:   "baldeagle.1973",
+			channel:  "lobby",
+			wantUser: "sip:.demo.baldeagle.1973.@tla.vivox.com",
+		},
+		{
+			name:     "percent encoded ID too long",
+			encoding: IDEncodingPercent,
+			userID:   strings.Repeat("ë", 10),
+			channel:  "lobby",
+			wantErr:  "user_id must not be longer than 56 characters after percent encoding",
+		},
+		{
+			name:     "hash replaces unsafe IDs",
+			encoding: IDEncodingHash,
+			userID:   "bëef@example.com",
+			channel:  "lobby",
+			wantUser: "sip:.demo.h-9c6c0537b2797c9273fe3893e8604b72.@tla.vivox.com",
+		},
+		{
+			name:     "hash replaces IDs too long",
+			encoding: IDEncodingHash,
+			userID:   strings.Repeat("a", 100),
+			channel:  "lobby",
+			wantUser: "sip:.demo.h-2816597888e4a0d3a36b82b83316ab32.@tla.vivox.com",
+		},
+		{
+			name:     "hash keeps safe IDs",
+			encoding: IDEncodingHash,
+			userID:   "baldeagle.1973",
+			channel:  "lobby",
+			wantUser: "sip:.demo.baldeagle.1973.@tla.vivox.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := NewIssuer("demo", "tla.vivox.com", "secret!", WithIDEncoding(tt.encoding))
+
+			token, err := issuer.Join(tt.userID, Channel{Type: ChannelEcho, ID: tt.channel}, time.Minute)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, token.Claims.F)
+
+			// the same ID always maps to the same URI
+			again, err := issuer.Join(tt.userID, Channel{Type: ChannelEcho, ID: tt.channel}, time.Minute)
+			require.NoError(t, err)
+			assert.Equal(t, token.Claims.F, again.Claims.F)
+			assert.Equal(t, token.Claims.T, again.Claims.T)
+		})
+	}
+}
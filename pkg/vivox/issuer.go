@@ -0,0 +1,223 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultProtocol      = "sip"
+	DefaultChannelPrefix = "confctl"
+)
+
+// ErrIssuerInvalid is returned when tokens are requested from an issuer without a name, domain or signing key
+var ErrIssuerInvalid = errors.New("vivox issuer, domain and signing key are required")
+
+// Issuer signs the Vivox access tokens of one Vivox application
+type Issuer struct {
+	issuer        string
+	domain        string
+	signingKey    string
+	protocol      string
+	channelPrefix string
+	idEncoding    IDEncoding
+	now           func() time.Time
+	serials       SerialSource
+}
+
+// IssuerOption configures optional settings of an Issuer
+type IssuerOption func(*Issuer)
+
+// WithProtocol sets the URI scheme of user and channel URIs, defaults to sip
+func WithProtocol(protocol string) IssuerOption {
+	return func(i *Issuer) {
+		if protocol != "" {
+			i.protocol = protocol
+		}
+	}
+}
+
+// WithChannelPrefix sets the prefix of channel names, defaults to confctl
+func WithChannelPrefix(prefix string) IssuerOption {
+	return func(i *Issuer) {
+		if prefix != "" {
+			i.channelPrefix = prefix
+		}
+	}
+}
+
+// WithIDEncoding sets how user and channel IDs that are not Vivox-safe are handled, defaults to rejecting them
+func WithIDEncoding(encoding IDEncoding) IssuerOption {
+	return func(i *Issuer) {
+		if encoding != "" {
+			i.idEncoding = encoding
+		}
+	}
+}
+
+// WithClock sets the clock token expiries are computed from, defaults to time.Now
+func WithClock(now func() time.Time) IssuerOption {
+	return func(i *Issuer) {
+		i.now = now
+	}
+}
+
+// WithSerialSource sets the source of token serial numbers, defaults to cryptographically random serials
+func WithSerialSource(serials SerialSource) IssuerOption {
+	return func(i *Issuer) {
+		i.serials = serials
+	}
+}
+
+// NewIssuer returns an Issuer signing tokens of the Vivox application issuer on domain with signingKey
+func NewIssuer(issuer, domain, signingKey string, opts ...IssuerOption) *Issuer {
+	i := &Issuer{
+		issuer:        issuer,
+		domain:        domain,
+		signingKey:    signingKey,
+		protocol:      DefaultProtocol,
+		channelPrefix: DefaultChannelPrefix,
+		idEncoding:    IDEncodingNone,
+		now:           time.Now,
+		serials:       NewCryptoSerialSource(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
+}
+
+// Name returns the Vivox issuer, the iss claim of its tokens
+func (i *Issuer) Name() string {
+	return i.issuer
+}
+
+// UserURI returns the URI of userID, e.g. sip:.issuer.userID.@domain
+func (i *Issuer) UserURI(userID string) (string, error) {
+	return i.userURI(FieldUserID, userID)
+}
+
+// TargetUserURI returns the URI of the target userID of a kick or mute, reporting invalid IDs as the target's
+func (i *Issuer) TargetUserURI(userID string) (string, error) {
+	return i.userURI(FieldTargetUserID, userID)
+}
+
+// ChannelURI returns the URI of channel, e.g. sip:confctl-g-issuer.channelID@domain
+func (i *Issuer) ChannelURI(channel Channel) (string, error) {
+	var props string
+	if channel.Properties != nil {
+		props = channel.Properties.String()
+	}
+	maxLength := MaxChannelNameLength - len(i.channelPrefix+channel.Type.code()+i.issuer+"."+props)
+	id, err := i.idEncoding.encodeID(FieldChannelID, channel.ID, maxLength, "!")
+	if err != nil {
+		return "", err
+	}
+	channel.ID = id
+
+	return i.protocol + ":" + channelName(i.channelPrefix, channel, i.issuer) + "@" + i.domain, nil
+}
+
+func (i *Issuer) userURI(field, userID string) (string, error) {
+	id, err := i.idEncoding.encodeID(field, userID, MaxUserNameLength-len(userName(i.issuer, "")), "")
+	if err != nil {
+		return "", err
+	}
+
+	return i.protocol + ":" + userName(i.issuer, id) + "@" + i.domain, nil
+}
+
+// ServerURI returns the URI addressing every channel of the issuer
+func (i *Issuer) ServerURI() string {
+	return i.protocol + ":" + serverName(i.issuer) + "@" + i.domain
+}
+
+// Sign signs claims as they are, for claims not built by the Issuer
+func (i *Issuer) Sign(claims Claims) (string, error) {
+	if err := i.validate(); err != nil {
+		return "", err
+	}
+
+	return Encode(claims, i.signingKey)
+}
+
+// Login returns a token allowing userID to log in for ttl
+func (i *Issuer) Login(userID string, ttl time.Duration) (Token, error) {
+	return i.issue(ActionLogin, userID, "", nil, ttl)
+}
+
+// Join returns a token allowing userID to join channel for ttl
+func (i *Issuer) Join(userID string, channel Channel, ttl time.Duration) (Token, error) {
+	return i.issue(ActionJoin, userID, "", &channel, ttl)
+}
+
+// JoinMuted returns a token allowing userID to join channel muted for ttl
+func (i *Issuer) JoinMuted(userID string, channel Channel, ttl time.Duration) (Token, error) {
+	return i.issue(ActionJoinMuted, userID, "", &channel, ttl)
+}
+
+// Kick returns a token allowing fromUserID to kick toUserID from channel for ttl.
+// A channel without ID kicks the user from the entire server.
+func (i *Issuer) Kick(fromUserID, toUserID string, channel Channel, ttl time.Duration) (Token, error) {
+	if channel.ID == "" {
+		return i.issue(ActionKick, fromUserID, toUserID, nil, ttl)
+	}
+
+	return i.issue(ActionKick, fromUserID, toUserID, &channel, ttl)
+}
+
+// Mute returns a token allowing fromUserID to mute toUserID in channel for ttl
+func (i *Issuer) Mute(fromUserID, toUserID string, channel Channel, ttl time.Duration) (Token, error) {
+	return i.issue(ActionMute, fromUserID, toUserID, &channel, ttl)
+}
+
+// Transcription returns a token allowing userID to transcribe channel for ttl
+func (i *Issuer) Transcription(userID string, channel Channel, ttl time.Duration) (Token, error) {
+	return i.issue(ActionTranscription, userID, "", &channel, ttl)
+}
+
+// issue signs a token of action from userID, on targetUserID when set, in channel when set
+func (i *Issuer) issue(action, userID, targetUserID string, channel *Channel, ttl time.Duration) (Token, error) {
+	claims := Claims{Iss: i.issuer, Vxa: action}
+
+	var err error
+	if claims.F, err = i.userURI(FieldUserID, userID); err != nil {
+		return Token{}, err
+	}
+	if action == ActionKick || action == ActionMute {
+		if claims.Sub, err = i.userURI(FieldTargetUserID, targetUserID); err != nil {
+			return Token{}, err
+		}
+	}
+	switch {
+	case channel != nil:
+		if claims.T, err = i.ChannelURI(*channel); err != nil {
+			return Token{}, err
+		}
+	case action == ActionKick:
+		claims.T = i.ServerURI()
+	}
+
+	claims.Vxi = i.serials.Next()
+	claims.Exp = i.now().Add(ttl).Unix()
+	accessToken, err := i.Sign(claims)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return Token{AccessToken: accessToken, Claims: claims}, nil
+}
+
+func (i *Issuer) validate() error {
+	if i.issuer == "" || i.domain == "" || i.signingKey == "" {
+		return errors.WithStack(ErrIssuerInvalid)
+	}
+
+	return nil
+}
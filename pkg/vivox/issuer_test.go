@@ -0,0 +1,119 @@
+// Copyright (c) 2024-2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedSerial int64
+
+func (s fixedSerial) Next() int64 {
+	return int64(s)
+}
+
+// testIssuer signs tokens expiring at 2016-01-01T00:00:00Z when issued with a 90 seconds ttl
+func testIssuer(serial int64) *Issuer {
+	now := time.Date(2015, 12, 31, 23, 58, 30, 0, time.UTC)
+
+	return NewIssuer("demo", "tla.vivox.com", "secret!",
+		WithClock(func() time.Time { return now }),
+		WithSerialSource(fixedSerial(serial)))
+}
+
+const testTTL = 90 * time.Second
+
+func TestIssuerLogin(t *testing.T) {
+	token, err := testIssuer(10047).Login("baldeagle.1973", testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "e30.eyJ2eGkiOjEwMDQ3LCJmIjoic2lwOi5kZW1vLmJhbGRlYWdsZS4xOTczLkB0bGEudml2b3guY29tIiwiaXNzIjoiZGVtbyIsInZ4YSI6ImxvZ2luIiwiZXhwIjoxNDUxNjA2NDAwfQ.yJIgDg_l4hvkofzDXQEzuCELuLhurn_DVgF2mmUZls8", token.AccessToken)
+}
+func TestIssuerJoin(t *testing.T) {
+	token, err := testIssuer(446905).Join("baldeagle.1973", Channel{Type: ChannelNonPositional, ID: "Qe3MHlbSq"}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:confctl-g-demo.Qe3MHlbSq@tla.vivox.com", token.Claims.T)
+	assert.Equal(t, "e30.eyJ2eGkiOjQ0NjkwNSwiZiI6InNpcDouZGVtby5iYWxkZWFnbGUuMTk3My5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJqb2luIiwidCI6InNpcDpjb25mY3RsLWctZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.I8GFxRwzYERWqT8XCuFaeVNE-zXEPtE6_7Qf1KR8PQI",
+		token.AccessToken)
+}
+func TestIssuerKick(t *testing.T) {
+	token, err := testIssuer(303167).Kick("Demo-Admin", "kingfisher.1364", Channel{Type: ChannelNonPositional, ID: "Qe3MHlbSq"}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:.demo.kingfisher.1364.@tla.vivox.com", token.Claims.Sub)
+	assert.Equal(t, "e30.eyJ2eGkiOjMwMzE2Nywic3ViIjoic2lwOi5kZW1vLmtpbmdmaXNoZXIuMTM2NC5AdGxhLnZpdm94LmNvbSIsImYiOiJzaXA6LmRlbW8uRGVtby1BZG1pbi5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJraWNrIiwidCI6InNpcDpjb25mY3RsLWctZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.26OxJW9ZjN5DoJCjN7k8qRCZ9PjCxMN5mCu8caNqSxU",
+		token.AccessToken)
+}
+func TestIssuerKickServer(t *testing.T) {
+	token, err := testIssuer(303167).Kick("Demo-Admin", "kingfisher.1364", Channel{}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:demo-service@tla.vivox.com", token.Claims.T)
+	assert.Equal(t, "sip:.demo.kingfisher.1364.@tla.vivox.com", token.Claims.Sub)
+
+	// Test values taken from:
+	// https://docs.vivox.com/v5/general/unity/15_1_160000/en-us/access-token-guide/access-token-examples/example-kick-token.htm
+	issuer := NewIssuer("blindmelon-AppName-dev", "tla.vivox.com", "secret!")
+	adminToken, err := issuer.Sign(Claims{
+		Iss: "blindmelon-AppName-dev",
+		Sub: "sip:.blindmelon-AppName-dev.jerky.@tla.vivox.com",
+		Exp: 1600349400,
+		Vxa: ActionKick,
+		Vxi: 613642,
+		F:   "sip:blindmelon-AppName-dev-Admin@tla.vivox.com",
+		T:   issuer.ServerURI(),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "e30.eyJ2eGkiOjYxMzY0Miwic3ViIjoic2lwOi5ibGluZG1lbG9uLUFwcE5hbWUtZGV2Lmplcmt5LkB0bGEudml2b3guY29tIiwiZiI6InNpcDpibGluZG1lbG9uLUFwcE5hbWUtZGV2LUFkbWluQHRsYS52aXZveC5jb20iLCJpc3MiOiJibGluZG1lbG9uLUFwcE5hbWUtZGV2IiwidnhhIjoia2ljayIsInQiOiJzaXA6YmxpbmRtZWxvbi1BcHBOYW1lLWRldi1zZXJ2aWNlQHRsYS52aXZveC5jb20iLCJleHAiOjE2MDAzNDk0MDB9.jinc73lQ_ZSN4Mb8WLFK7Clu-Se9LG-QifXKfpaa3g4",
+		adminToken)
+}
+func TestIssuerMute(t *testing.T) {
+	token, err := testIssuer(303167).Mute("Demo-Admin", "kingfisher.1364", Channel{Type: ChannelNonPositional, ID: "Qe3MHlbSq"}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "e30.eyJ2eGkiOjMwMzE2Nywic3ViIjoic2lwOi5kZW1vLmtpbmdmaXNoZXIuMTM2NC5AdGxhLnZpdm94LmNvbSIsImYiOiJzaXA6LmRlbW8uRGVtby1BZG1pbi5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJtdXRlIiwidCI6InNpcDpjb25mY3RsLWctZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.R-V2pLtCtiDBvG8rza0f8v2zDYA7MI7H4ccC9RZ0yGc",
+		token.AccessToken)
+}
+func TestIssuerTranscription(t *testing.T) {
+	token, err := testIssuer(446905).Transcription("baldeagle.1973", Channel{Type: ChannelNonPositional, ID: "Qe3MHlbSq"}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "e30.eyJ2eGkiOjQ0NjkwNSwiZiI6InNpcDouZGVtby5iYWxkZWFnbGUuMTk3My5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJ0cnhuIiwidCI6InNpcDpjb25mY3RsLWctZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.df8ehYvhKKpezo7VWKW8iTqaDsACW7jj5BRxfRkWwWM",
+		token.AccessToken)
+}
+func TestIssuerJoinPositional(t *testing.T) {
+	props := &ChannelProperties{AudibleDistance: 32, ConversationalDistance: 1, FadeIntensity: 1.0, FadeModel: 1}
+	token, err := testIssuer(446905).Join("baldeagle.1973", Channel{Type: ChannelPositional, ID: "Qe3MHlbSq", Properties: props}, testTTL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:confctl-d-demo.Qe3MHlbSq!p-32-1-1.000-1@tla.vivox.com", token.Claims.T)
+	assert.Equal(t, "e30.eyJ2eGkiOjQ0NjkwNSwiZiI6InNpcDouZGVtby5iYWxkZWFnbGUuMTk3My5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJqb2luIiwidCI6InNpcDpjb25mY3RsLWQtZGVtby5RZTNNSGxiU3EhcC0zMi0xLTEuMDAwLTFAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.pS5xw08G7m20MeANEKREwvGA4KOU_-3Ic7XhYxv-V1M",
+		token.AccessToken)
+}
+func TestIssuerOptions(t *testing.T) {
+	issuer := NewIssuer("demo", "tla.vivox.com", "secret!", WithProtocol("sips"), WithChannelPrefix("voice"))
+
+	token, err := issuer.Join("beef", Channel{Type: ChannelEcho, ID: "lobby"}, time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sips:.demo.beef.@tla.vivox.com", token.Claims.F)
+	assert.Equal(t, "sips:voice-e-demo.lobby@tla.vivox.com", token.Claims.T)
+	assert.Greater(t, token.Claims.Vxi, int64(0))
+
+	claims, err := Verify(token.AccessToken, "secret!", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, token.Claims, *claims)
+}
+func TestIssuerInvalid(t *testing.T) {
+	_, err := NewIssuer("demo", "tla.vivox.com", "").Login("beef", time.Minute)
+
+	assert.ErrorIs(t, err, ErrIssuerInvalid)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrURIMalformed      = errors.New("vivox URI is malformed")
+	ErrURIIssuerMismatch = errors.New("vivox URI does not belong to the issuer")
+)
+
+// URIKind is what a Vivox URI addresses
+type URIKind string
+
+const (
+	URIKindUser    URIKind = "user"
+	URIKindChannel URIKind = "channel"
+	URIKindServer  URIKind = "server"
+)
+
+// ParsedURI is a Vivox URI split back into the parts it is built from
+type ParsedURI struct {
+	Kind     URIKind
+	Protocol string
+	Issuer   string
+	Domain   string
+	// set for user URIs
+	UserID string
+	// set for channel URIs
+	ChannelPrefix string
+	Channel       Channel
+}
+
+// ParseURI is the inverse of the user, channel and server URIs of an issuer, e.g. sip:.issuer.userID.@domain,
+// sip:confctl-g-issuer.channelID@domain or sip:issuer-service@domain. Channel names must start with channelPrefix.
+// IDs are returned as they appear in the URI, see Issuer.ParseURI to decode them.
+func ParseURI(uri, channelPrefix string) (ParsedURI, error) {
+	protocol, address, found := strings.Cut(uri, ":")
+	if !found || protocol == "" {
+		return ParsedURI{}, errors.Wrap(ErrURIMalformed, "missing protocol")
+	}
+	at := strings.LastIndex(address, "@")
+	if at < 0 || at == len(address)-1 {
+		return ParsedURI{}, errors.Wrap(ErrURIMalformed, "missing domain")
+	}
+	name, domain := address[:at], address[at+1:]
+	parsed := ParsedURI{Protocol: protocol, Domain: domain}
+
+	switch {
+	case strings.HasPrefix(name, "."):
+		// .issuer.userID.
+		if len(name) < 2 || !strings.HasSuffix(name, ".") {
+			return ParsedURI{}, errors.Wrap(ErrURIMalformed, "user name must be .issuer.userID.")
+		}
+		issuer, userID, found := strings.Cut(name[1:len(name)-1], ".")
+		if !found || issuer == "" || userID == "" {
+			return ParsedURI{}, errors.Wrap(ErrURIMalformed, "user name must be .issuer.userID.")
+		}
+		parsed.Kind, parsed.Issuer, parsed.UserID = URIKindUser, issuer, userID
+
+	case !strings.Contains(name, ".") && strings.HasSuffix(name, "-service"):
+		// issuer-service
+		parsed.Kind, parsed.Issuer = URIKindServer, strings.TrimSuffix(name, "-service")
+		if parsed.Issuer == "" {
+			return ParsedURI{}, errors.Wrap(ErrURIMalformed, "server name must be issuer-service")
+		}
+
+	case channelPrefix != "" && strings.HasPrefix(name, channelPrefix):
+		// prefix-t-issuer.channelID!p-a-c-f-m
+		rest := strings.TrimPrefix(name, channelPrefix)
+		for _, channelType := range []ChannelType{ChannelEcho, ChannelPositional, ChannelNonPositional} {
+			if strings.HasPrefix(rest, channelType.code()) {
+				parsed.Channel.Type = channelType
+				rest = strings.TrimPrefix(rest, channelType.code())
+
+				break
+			}
+		}
+		issuer, channelID, found := strings.Cut(rest, ".")
+		if !found || issuer == "" || channelID == "" {
+			return ParsedURI{}, errors.Wrap(ErrURIMalformed, "channel name must be prefix-t-issuer.channelID")
+		}
+		if channelID, props, hasProps := strings.Cut(channelID, "!"); hasProps {
+			properties, err := parseChannelProperties(props)
+			if err != nil {
+				return ParsedURI{}, err
+			}
+			parsed.Channel.ID, parsed.Channel.Properties = channelID, &properties
+		} else {
+			parsed.Channel.ID = channelID
+		}
+		parsed.Kind, parsed.Issuer, parsed.ChannelPrefix = URIKindChannel, issuer, channelPrefix
+
+	default:
+		return ParsedURI{}, errors.Wrapf(ErrURIMalformed, "%q is not a user, channel or server name", name)
+	}
+
+	return parsed, nil
+}
+
+// ParseURI parses a URI of the issuer, decoding the IDs with its ID encoding
+func (i *Issuer) ParseURI(uri string) (ParsedURI, error) {
+	parsed, err := ParseURI(uri, i.channelPrefix)
+	if err != nil {
+		return ParsedURI{}, err
+	}
+	if parsed.Protocol != i.protocol || parsed.Issuer != i.issuer || parsed.Domain != i.domain {
+		return parsed, errors.Wrapf(ErrURIIssuerMismatch, "expected %s:...%s...@%s", i.protocol, i.issuer, i.domain)
+	}
+
+	switch parsed.Kind {
+	case URIKindUser:
+		parsed.UserID, err = i.idEncoding.decodeID(FieldUserID, parsed.UserID)
+	case URIKindChannel:
+		parsed.Channel.ID, err = i.idEncoding.decodeID(FieldChannelID, parsed.Channel.ID)
+	}
+	if err != nil {
+		return ParsedURI{}, err
+	}
+
+	return parsed, nil
+}
+
+// parseChannelProperties is the inverse of ChannelProperties.String, without the leading '!'
+func parseChannelProperties(props string) (ChannelProperties, error) {
+	malformed := errors.Wrapf(ErrURIMalformed, "channel properties %q must be p-audibleDistance-conversationalDistance-fadeIntensity-fadeModel", props)
+
+	fields := strings.Split(props, "-")
+	if len(fields) != 5 || fields[0] != "p" {
+		return ChannelProperties{}, malformed
+	}
+	audible, errAudible := strconv.ParseInt(fields[1], 10, 32)
+	conversational, errConversational := strconv.ParseInt(fields[2], 10, 32)
+	intensity, errIntensity := strconv.ParseFloat(fields[3], 64)
+	model, errModel := strconv.ParseInt(fields[4], 10, 32)
+	if errAudible != nil || errConversational != nil || errIntensity != nil || errModel != nil {
+		return ChannelProperties{}, malformed
+	}
+
+	return ChannelProperties{
+		AudibleDistance:        int32(audible),
+		ConversationalDistance: int32(conversational),
+		FadeIntensity:          intensity,
+		FadeModel:              int32(model),
+	}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    ParsedURI
+		wantErr error
+	}{
+		{
+			name: "user",
+			uri:  "sip:.blindmelon-AppName-dev.baldeagle.1973.@tla.vivox.com",
+			want: ParsedURI{Kind: URIKindUser, Protocol: "sip", Issuer: "blindmelon-AppName-dev", Domain: "tla.vivox.com", UserID: "baldeagle.1973"},
+		},
+		{
+			name: "nonpositional channel",
+			uri:  "sip:confctl-g-issuer.channel@tla.vivox.com",
+			want: ParsedURI{
+				Kind: URIKindChannel, Protocol: "sip", Issuer: "issuer", Domain: "tla.vivox.com", ChannelPrefix: "confctl",
+				Channel: Channel{Type: ChannelNonPositional, ID: "channel"},
+			},
+		},
+		{
+			name: "positional channel with properties",
+			uri:  "sip:confctl-d-demo.Qe3MHlbSq!p-32-1-1.000-1@tla.vivox.com",
+			want: ParsedURI{
+				Kind: URIKindChannel, Protocol: "sip", Issuer: "demo", Domain: "tla.vivox.com", ChannelPrefix: "confctl",
+				Channel: Channel{
+					Type: ChannelPositional, ID: "Qe3MHlbSq",
+					Properties: &ChannelProperties{AudibleDistance: 32, ConversationalDistance: 1, FadeIntensity: 1, FadeModel: 1},
+				},
+			},
+		},
+		{
+			name: "channel without type",
+			uri:  "sip:confctldemo.Qe3MHlbSq@tla.vivox.com",
+			want: ParsedURI{
+				Kind: URIKindChannel, Protocol: "sip", Issuer: "demo", Domain: "tla.vivox.com", ChannelPrefix: "confctl",
+				Channel: Channel{ID: "Qe3MHlbSq"},
+			},
+		},
+		{
+			name: "server",
+			uri:  "sip:blindmelon-AppName-dev-service@tla.vivox.com",
+			want: ParsedURI{Kind: URIKindServer, Protocol: "sip", Issuer: "blindmelon-AppName-dev", Domain: "tla.vivox.com"},
+		},
+		{name: "missing protocol", uri: ".demo.beef.@tla.vivox.com", wantErr: ErrURIMalformed},
+		{name: "missing domain", uri: "sip:.demo.beef.", wantErr: ErrURIMalformed},
+		{name: "user without issuer", uri: "sip:.beef.@tla.vivox.com", wantErr: ErrURIMalformed},
+		{name: "user without closing dot", uri: "sip:.demo.beef@tla.vivox.com", wantErr: ErrURIMalformed},
+		{name: "channel without id", uri: "sip:confctl-g-demo@tla.vivox.com", wantErr: ErrURIMalformed},
+		{name: "channel with bad properties", uri: "sip:confctl-d-demo.lobby!p-32-1@tla.vivox.com", wantErr: ErrURIMalformed},
+		{name: "other prefix", uri: "sip:other-g-demo.lobby@tla.vivox.com", wantErr: ErrURIMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseURI(tt.uri, DefaultChannelPrefix)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, parsed)
+		})
+	}
+}
+
+func TestIssuerParseURIRoundTrip(t *testing.T) {
+	issuer := NewIssuer("demo", "tla.vivox.com", "secret!", WithIDEncoding(IDEncodingPercent), WithChannelPrefix("voice"))
+	channel := Channel{
+		Type: ChannelPositional, ID: "party 1",
+		Properties: &ChannelProperties{AudibleDistance: 40, ConversationalDistance: 2, FadeIntensity: 1.5, FadeModel: 2},
+	}
+
+	token, err := issuer.Kick("bëef", "jerky", channel, time.Minute)
+	require.NoError(t, err)
+
+	from, err := issuer.ParseURI(token.Claims.F)
+	require.NoError(t, err)
+	assert.Equal(t, URIKindUser, from.Kind)
+	assert.Equal(t, "bëef", from.UserID)
+
+	to, err := issuer.ParseURI(token.Claims.T)
+	require.NoError(t, err)
+	assert.Equal(t, URIKindChannel, to.Kind)
+	assert.Equal(t, channel, to.Channel)
+
+	server, err := issuer.ParseURI(issuer.ServerURI())
+	require.NoError(t, err)
+	assert.Equal(t, URIKindServer, server.Kind)
+}
+
+func TestIssuerParseURIMismatch(t *testing.T) {
+	issuer := NewIssuer("demo", "tla.vivox.com", "secret!")
+
+	for _, uri := range []string{
+		"sip:.other.beef.@tla.vivox.com",
+		"sip:.demo.beef.@other.vivox.com",
+		"sips:.demo.beef.@tla.vivox.com",
+	} {
+		_, err := issuer.ParseURI(uri)
+
+		assert.ErrorIs(t, err, ErrURIIssuerMismatch, uri)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync/atomic"
+)
+
+// maxSerial keeps serial numbers within the integer range JSON clients can represent exactly
+const maxSerial = int64(1)<<53 - 1
+
+// SerialSource generates the vxi serial numbers of Vivox tokens
+type SerialSource interface {
+	Next() int64
+}
+
+// NewCryptoSerialSource returns a SerialSource of cryptographically random serials between 1 and 2^53-1
+func NewCryptoSerialSource() SerialSource {
+	return cryptoSerialSource{}
+}
+
+type cryptoSerialSource struct{}
+
+func (cryptoSerialSource) Next() int64 {
+	var b [8]byte
+	for {
+		_, _ = rand.Read(b[:]) // crashes the program instead of returning an error
+
+		if serial := int64(binary.BigEndian.Uint64(b[:]) & uint64(maxSerial)); serial != 0 {
+			return serial
+		}
+	}
+}
+
+// NewMonotonicSerialSource returns a SerialSource of increasing serials, starting after start.
+// Serials are unique within the process; seed start with the current time to stay unique across restarts.
+func NewMonotonicSerialSource(start int64) *MonotonicSerialSource {
+	s := &MonotonicSerialSource{}
+	s.last.Store(start)
+
+	return s
+}
+
+type MonotonicSerialSource struct {
+	last atomic.Int64
+}
+
+func (s *MonotonicSerialSource) Next() int64 {
+	return s.last.Add(1)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCryptoSerialSource(t *testing.T) {
+	serials := NewCryptoSerialSource()
+	seen := make(map[int64]bool)
+
+	for i := 0; i < 10000; i++ {
+		serial := serials.Next()
+		assert.Greater(t, serial, int64(0))
+		assert.LessOrEqual(t, serial, maxSerial)
+		assert.Falsef(t, seen[serial], "serial %d is duplicated", serial)
+		seen[serial] = true
+	}
+}
+
+func TestMonotonicSerialSource(t *testing.T) {
+	serials := NewMonotonicSerialSource(1000)
+	assert.Equal(t, int64(1001), serials.Next())
+	assert.Equal(t, int64(1002), serials.Next())
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[int64]bool)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				serial := serials.Next()
+				mu.Lock()
+				assert.Falsef(t, seen[serial], "serial %d is duplicated", serial)
+				seen[serial] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, 5000)
+	assert.Equal(t, int64(6003), serials.Next())
+}
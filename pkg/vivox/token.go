@@ -0,0 +1,155 @@
+// Copyright (c) 2024-2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Claims is the payload of a Vivox access token
+type Claims struct {
+	Vxi int64  `json:"vxi"`
+	Sub string `json:"sub,omitempty"`
+	F   string `json:"f,omitempty"`
+	Iss string `json:"iss"`
+	Vxa string `json:"vxa"`
+	T   string `json:"t,omitempty"`
+	Exp int64  `json:"exp"`
+}
+
+// Token is a signed Vivox access token with the claims it carries
+type Token struct {
+	AccessToken string
+	Claims      Claims
+}
+
+const (
+	ActionJoin          = "join"
+	ActionJoinMuted     = "join_muted"
+	ActionKick          = "kick"
+	ActionLogin         = "login"
+	ActionMute          = "mute"
+	ActionTranscription = "trxn"
+)
+
+var (
+	ErrTokenMalformed        = errors.New("token is malformed")
+	ErrTokenSignatureInvalid = errors.New("token signature is invalid")
+	ErrTokenExpired          = errors.New("token is expired")
+	ErrTokenIssuerMismatch   = errors.New("token issuer does not match")
+)
+
+// Encode signs claims with signingKey, returning the access token
+func Encode(claims Claims, signingKey string) (string, error) {
+	header := make(map[string]any)
+	headerMarshal, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("error make token: %w", err)
+	}
+	payloadMarshal, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("error make token: %w", err)
+	}
+	signature, err := Sign(header, claims, signingKey)
+	if err != nil {
+		return "", fmt.Errorf("error make token: %w", err)
+	}
+
+	return strings.Join([]string{Base64URLEncode(string(headerMarshal)), Base64URLEncode(string(payloadMarshal)), signature}, "."), nil
+}
+
+// Decode returns the header and claims of token without checking its signature
+func Decode(token string) (map[string]any, *Claims, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return nil, nil, errors.Wrapf(ErrTokenMalformed, "expected 3 segments, got %d", len(segments))
+	}
+
+	headerJSON, err := Base64URLDecode(segments[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrTokenMalformed, "header is not base64url encoded")
+	}
+	header := make(map[string]any)
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, nil, errors.Wrap(ErrTokenMalformed, "header is not a JSON object")
+	}
+
+	payloadJSON, err := Base64URLDecode(segments[1])
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrTokenMalformed, "payload is not base64url encoded")
+	}
+	var claims Claims
+	if err := json.Unmarshal(payloadJSON, &claims); err != nil {
+		return nil, nil, errors.Wrap(ErrTokenMalformed, "payload is not a valid claims object")
+	}
+
+	return header, &claims, nil
+}
+
+// Verify checks the token signature against signingKey and its expiry against now.
+// The decoded claims are returned whenever the token could be decoded, even if it is not valid.
+func Verify(token, signingKey string, now time.Time) (*Claims, error) {
+	_, claims, err := Decode(token)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(token, ".")
+	expected := HmacBase64Encode(segments[0]+"."+segments[1], signingKey)
+	if !hmac.Equal([]byte(expected), []byte(segments[2])) {
+		return claims, ErrTokenSignatureInvalid
+	}
+
+	if !now.Before(time.Unix(claims.Exp, 0)) {
+		return claims, errors.Wrapf(ErrTokenExpired, "expired at %s", time.Unix(claims.Exp, 0).UTC().Format(time.RFC3339))
+	}
+
+	return claims, nil
+}
+
+func Sign(header map[string]any, claims Claims, key string) (string, error) {
+	headerMarshal, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode header when signing token with error %w", err)
+	}
+	headerString := string(headerMarshal)
+	payloadMarshal, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode claims when signing token with error %w", err)
+	}
+	payloadString := string(payloadMarshal)
+	base64Header := Base64URLEncode(headerString)
+	base64Payload := Base64URLEncode(payloadString)
+
+	return HmacBase64Encode(base64Header+"."+base64Payload, key), nil
+}
+func Base64URLEncode(str string) string {
+	return base64EncodeAndReplaceChar([]byte(str))
+}
+func Base64URLDecode(str string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(str, "="))
+}
+func HmacBase64Encode(seed, key string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(seed))
+
+	return base64EncodeAndReplaceChar(h.Sum(nil))
+}
+func base64EncodeAndReplaceChar(byteArray []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(byteArray)
+	encoded = strings.ReplaceAll(encoded, "+", "-")
+	encoded = strings.ReplaceAll(encoded, "/", "_")
+	encoded = strings.Trim(encoded, "=")
+
+	return encoded
+}
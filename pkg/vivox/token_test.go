@@ -0,0 +1,139 @@
+// Copyright (c) 2024-2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"testing"
+	"time"
+)
+
+func TestVerifyToken(t *testing.T) {
+	// Kick token from TestGenerateTokenKick, expiring at 2016-01-01T00:00:00Z
+	kickToken := "e30.eyJ2eGkiOjMwMzE2Nywic3ViIjoic2lwOi5kZW1vLmtpbmdmaXNoZXIuMTM2NC5AdGxhLnZpdm94LmNvbSIsImYiOiJzaXA6LmRlbW8uRGVtby1BZG1pbi5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJraWNrIiwidCI6InNpcDpjb25mY3RsZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.AetRLye3w7pYpfhZWudGci8W3bgCET5y0ShZ7hkCHs8"
+	beforeExpiry := time.Date(2015, 12, 31, 23, 59, 0, 0, time.UTC)
+	afterExpiry := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		token      string
+		key        string
+		now        time.Time
+		wantErr    error
+		wantClaims bool
+	}{
+		{
+			name:       "valid token",
+			token:      kickToken,
+			key:        "secret!",
+			now:        beforeExpiry,
+			wantClaims: true,
+		},
+		{
+			name:       "expired token",
+			token:      kickToken,
+			key:        "secret!",
+			now:        afterExpiry,
+			wantErr:    ErrTokenExpired,
+			wantClaims: true,
+		},
+		{
+			name:       "wrong signing key",
+			token:      kickToken,
+			key:        "another-secret",
+			now:        beforeExpiry,
+			wantErr:    ErrTokenSignatureInvalid,
+			wantClaims: true,
+		},
+		{
+			name:       "tampered signature",
+			token:      kickToken[:len(kickToken)-1] + "9",
+			key:        "secret!",
+			now:        beforeExpiry,
+			wantErr:    ErrTokenSignatureInvalid,
+			wantClaims: true,
+		},
+		{
+			name:    "missing segment",
+			token:   "e30.eyJ2eGkiOjF9",
+			key:     "secret!",
+			now:     beforeExpiry,
+			wantErr: ErrTokenMalformed,
+		},
+		{
+			name:    "payload not base64url",
+			token:   "e30.not*base64.sig",
+			key:     "secret!",
+			now:     beforeExpiry,
+			wantErr: ErrTokenMalformed,
+		},
+		{
+			name:    "payload not claims",
+			token:   "e30.W10.sig",
+			key:     "secret!",
+			now:     beforeExpiry,
+			wantErr: ErrTokenMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := Verify(tt.token, tt.key, tt.now)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+			if tt.wantClaims {
+				assert.Equal(t, &Claims{
+					Vxi: 303167,
+					Sub: "sip:.demo.kingfisher.1364.@tla.vivox.com",
+					F:   "sip:.demo.Demo-Admin.@tla.vivox.com",
+					Iss: "demo",
+					Vxa: ActionKick,
+					T:   "sip:confctldemo.Qe3MHlbSq@tla.vivox.com",
+					Exp: 1451606400,
+				}, claims)
+			} else {
+				assert.Nil(t, claims)
+			}
+		})
+	}
+}
+
+// TestEncodeGolden keeps the tokens signed before the channel URIs carried their type code, e.g. confctldemo instead
+// of the confctl-g-demo of non-positional channels in the Vivox access token examples
+func TestEncodeGolden(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims Claims
+		want   string
+	}{
+		{
+			name:   "login",
+			claims: Claims{Vxi: 10047, F: "sip:.demo.baldeagle.1973.@tla.vivox.com", Iss: "demo", Vxa: ActionLogin, Exp: 1451606400},
+			want:   "e30.eyJ2eGkiOjEwMDQ3LCJmIjoic2lwOi5kZW1vLmJhbGRlYWdsZS4xOTczLkB0bGEudml2b3guY29tIiwiaXNzIjoiZGVtbyIsInZ4YSI6ImxvZ2luIiwiZXhwIjoxNDUxNjA2NDAwfQ.yJIgDg_l4hvkofzDXQEzuCELuLhurn_DVgF2mmUZls8",
+		},
+		{
+			name:   "join",
+			claims: Claims{Vxi: 446905, F: "sip:.demo.baldeagle.1973.@tla.vivox.com", Iss: "demo", Vxa: ActionJoin, T: "sip:confctldemo.Qe3MHlbSq@tla.vivox.com", Exp: 1451606400},
+			want:   "e30.eyJ2eGkiOjQ0NjkwNSwiZiI6InNpcDouZGVtby5iYWxkZWFnbGUuMTk3My5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJqb2luIiwidCI6InNpcDpjb25mY3RsZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.cz1dH_FDUprLmrOS86R3VIh9h16qAgnbCRkl2Pxp-eI",
+		},
+		{
+			name:   "kick",
+			claims: Claims{Vxi: 303167, Sub: "sip:.demo.kingfisher.1364.@tla.vivox.com", F: "sip:.demo.Demo-Admin.@tla.vivox.com", Iss: "demo", Vxa: ActionKick, T: "sip:confctldemo.Qe3MHlbSq@tla.vivox.com", Exp: 1451606400},
+			want:   "e30.eyJ2eGkiOjMwMzE2Nywic3ViIjoic2lwOi5kZW1vLmtpbmdmaXNoZXIuMTM2NC5AdGxhLnZpdm94LmNvbSIsImYiOiJzaXA6LmRlbW8uRGVtby1BZG1pbi5AdGxhLnZpdm94LmNvbSIsImlzcyI6ImRlbW8iLCJ2eGEiOiJraWNrIiwidCI6InNpcDpjb25mY3RsZGVtby5RZTNNSGxiU3FAdGxhLnZpdm94LmNvbSIsImV4cCI6MTQ1MTYwNjQwMH0.AetRLye3w7pYpfhZWudGci8W3bgCET5y0ShZ7hkCHs8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Encode(tt.claims, "secret!")
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, token)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024-2026 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package vivox
+
+import "fmt"
+
+// ChannelType is the kind of Vivox channel, encoded in the channel name
+type ChannelType string
+
+const (
+	ChannelEcho          ChannelType = "echo"
+	ChannelPositional    ChannelType = "positional"
+	ChannelNonPositional ChannelType = "nonpositional"
+)
+
+// code returns the channel name infix of the channel type
+func (c ChannelType) code() string {
+	switch c {
+	case ChannelEcho:
+		return "-e-"
+	case ChannelPositional:
+		return "-d-"
+	case ChannelNonPositional:
+		return "-g-"
+	default:
+		return ""
+	}
+}
+
+// Channel identifies a Vivox channel of an issuer
+type Channel struct {
+	Type ChannelType
+	ID   string
+	// 3D audio properties, only for positional channels
+	Properties *ChannelProperties
+}
+
+// ChannelProperties are the 3D audio properties of a positional channel
+type ChannelProperties struct {
+	AudibleDistance        int32
+	ConversationalDistance int32
+	FadeIntensity          float64
+	FadeModel              int32
+}
+
+// String returns the channel property suffix, e.g. !p-32-1-1.000-1
+func (p ChannelProperties) String() string {
+	return fmt.Sprintf("!p-%d-%d-%.3f-%d", p.AudibleDistance, p.ConversationalDistance, p.FadeIntensity, p.FadeModel)
+}
+
+func channelName(prefix string, channel Channel, issuer string) string {
+	name := prefix + channel.Type.code() + issuer + "." + channel.ID
+	if channel.Properties != nil {
+		name += channel.Properties.String()
+	}
+
+	return name
+}
+
+func userName(issuer, userID string) string {
+	return "." + issuer + "." + userID + "."
+}
+
+func serverName(issuer string) string {
+	return issuer + "-service"
+}